@@ -0,0 +1,74 @@
+package passport_validator
+
+import "time"
+
+// LeapDayPolicy определяет, когда у родившихся 29 февраля наступает день рождения в невисокосный год.
+// Так же разрешается любой перенос на несуществующее число месяца, например 31 января + 1 месяц.
+type LeapDayPolicy int
+
+const (
+	// LeapDayFeb28 день рождения наступает в последний день месяца, 28 февраля (ст. 192 ГК РФ)
+	LeapDayFeb28 LeapDayPolicy = iota
+	// LeapDayMar1 день рождения наступает на следующий день после последнего дня месяца, 1 марта
+	LeapDayMar1
+)
+
+// Age возраст в полных годах, месяцах и днях
+type Age struct {
+	Years  int
+	Months int
+	Days   int
+}
+
+// AgeAt возраст на дату date для родившегося birthday. Время и часовой пояс не учитываются,
+// сравниваются только календарные даты.
+func AgeAt(birthday, date time.Time, policy LeapDayPolicy) Age {
+	birthday, date = civilDate(birthday), civilDate(date)
+	if date.Before(birthday) {
+		return Age{}
+	}
+
+	years := date.Year() - birthday.Year()
+	if addCivilDate(birthday, years, 0, policy).After(date) {
+		years--
+	}
+
+	months := 0
+	for !addCivilDate(birthday, years, months+1, policy).After(date) {
+		months++
+	}
+
+	days := daysBetween(addCivilDate(birthday, years, months, policy), date)
+
+	return Age{Years: years, Months: months, Days: days}
+}
+
+// AnniversaryDate дата, когда родившемуся birthday исполняется years лет
+func AnniversaryDate(birthday time.Time, years int, policy LeapDayPolicy) time.Time {
+	return addCivilDate(civilDate(birthday), years, 0, policy)
+}
+
+// civilDate отбрасывает время и часовой пояс, оставляя календарную дату
+func civilDate(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// daysBetween количество календарных дней от from до to, отрицательное если to раньше from
+func daysBetween(from, to time.Time) int {
+	return int(civilDate(to).Sub(civilDate(from)).Hours() / 24)
+}
+
+// addCivilDate прибавляет годы и месяцы к календарной дате. Если в получившемся месяце нет
+// такого числа, дата переносится по policy, а не нормализуется как в time.AddDate.
+func addCivilDate(date time.Time, years, months int, policy LeapDayPolicy) time.Time {
+	firstDay := time.Date(date.Year()+years, date.Month()+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	lastDay := firstDay.AddDate(0, 1, -1).Day()
+
+	if date.Day() <= lastDay {
+		return firstDay.AddDate(0, 0, date.Day()-1)
+	}
+	if policy == LeapDayMar1 {
+		return firstDay.AddDate(0, 1, 0)
+	}
+	return firstDay.AddDate(0, 0, lastDay-1)
+}
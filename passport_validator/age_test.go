@@ -0,0 +1,93 @@
+package passport_validator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_AgeAt(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		birthday time.Time
+		date     time.Time
+		policy   LeapDayPolicy
+		want     Age
+	}{
+		"birthday": {
+			birthday: time.Date(2010, 2, 20, 0, 0, 0, 0, time.UTC),
+			date:     time.Date(2024, 2, 20, 0, 0, 0, 0, time.UTC),
+			want:     Age{Years: 14},
+		},
+		"day before birthday": {
+			birthday: time.Date(2010, 2, 20, 0, 0, 0, 0, time.UTC),
+			date:     time.Date(2024, 2, 19, 0, 0, 0, 0, time.UTC),
+			want:     Age{Years: 13, Months: 11, Days: 30},
+		},
+		"years months days": {
+			birthday: time.Date(1997, 5, 15, 0, 0, 0, 0, time.UTC),
+			date:     time.Date(2024, 2, 27, 0, 0, 0, 0, time.UTC),
+			want:     Age{Years: 26, Months: 9, Days: 12},
+		},
+		"time of day is ignored": {
+			birthday: time.Date(2010, 2, 20, 23, 59, 0, 0, time.UTC),
+			date:     time.Date(2024, 2, 20, 0, 1, 0, 0, time.UTC),
+			want:     Age{Years: 14},
+		},
+		"date before birthday": {
+			birthday: time.Date(2010, 2, 20, 0, 0, 0, 0, time.UTC),
+			date:     time.Date(2009, 2, 20, 0, 0, 0, 0, time.UTC),
+			want:     Age{},
+		},
+		"29 february feb28 policy on 28 february": {
+			birthday: time.Date(2004, 2, 29, 0, 0, 0, 0, time.UTC),
+			date:     time.Date(2018, 2, 28, 0, 0, 0, 0, time.UTC),
+			policy:   LeapDayFeb28,
+			want:     Age{Years: 14},
+		},
+		"29 february mar1 policy on 28 february": {
+			birthday: time.Date(2004, 2, 29, 0, 0, 0, 0, time.UTC),
+			date:     time.Date(2018, 2, 28, 0, 0, 0, 0, time.UTC),
+			policy:   LeapDayMar1,
+			want:     Age{Years: 13, Months: 11, Days: 30},
+		},
+		"29 february mar1 policy on 1 march": {
+			birthday: time.Date(2004, 2, 29, 0, 0, 0, 0, time.UTC),
+			date:     time.Date(2018, 3, 1, 0, 0, 0, 0, time.UTC),
+			policy:   LeapDayMar1,
+			want:     Age{Years: 14},
+		},
+		"29 february in leap year": {
+			birthday: time.Date(2004, 2, 29, 0, 0, 0, 0, time.UTC),
+			date:     time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+			policy:   LeapDayMar1,
+			want:     Age{Years: 20},
+		},
+		"31 january month step": {
+			birthday: time.Date(2000, 1, 31, 0, 0, 0, 0, time.UTC),
+			date:     time.Date(2001, 3, 30, 0, 0, 0, 0, time.UTC),
+			want:     Age{Years: 1, Months: 1, Days: 30},
+		},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, AgeAt(tt.birthday, tt.date, tt.policy))
+		})
+	}
+}
+
+func Test_AnniversaryDate(t *testing.T) {
+	t.Parallel()
+
+	birthday := time.Date(2004, 2, 29, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), AnniversaryDate(birthday, 20, LeapDayFeb28))
+	assert.Equal(t, time.Date(2049, 2, 28, 0, 0, 0, 0, time.UTC), AnniversaryDate(birthday, 45, LeapDayFeb28))
+	assert.Equal(t, time.Date(2049, 3, 1, 0, 0, 0, 0, time.UTC), AnniversaryDate(birthday, 45, LeapDayMar1))
+}
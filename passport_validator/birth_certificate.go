@@ -0,0 +1,153 @@
+package passport_validator
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	// Серия свидетельства о рождении и паспорта СССР: римское число, дефис и две заглавные буквы кириллицы, "IV-МЮ"
+	romanSeriesRegexp = regexp.MustCompile(`^([IVXLCDM]+)-([А-ЯЁ]{2})$`)
+	// Проверяем что номер свидетельства о рождении состоит из 6 цифр
+	birthCertificateNumberRegexp = regexp.MustCompile(`^\d{6}$`)
+)
+
+var (
+	ErrEmptyBirthCertificateSeries   = errors.New("birth certificate series is empty")
+	ErrInvalidBirthCertificateSeries = errors.New("birth certificate series is not a Roman numeral, hyphen and 2 Cyrillic letters")
+	ErrInvalidRomanNumeral           = errors.New("Roman numeral in birth certificate series is not a valid numeral")
+	ErrEmptyBirthCertificateNumber   = errors.New("birth certificate number is empty")
+	ErrInvalidBirthCertificateNumber = errors.New("birth certificate number is not 6 digits")
+)
+
+// BirthCertificate данные свидетельства о рождении — документа, удостоверяющего личность ребенка до 14 лет
+type BirthCertificate struct {
+	LastName   string
+	FirstName  string
+	MiddleName string
+	Series     string
+	Number     string
+	IssueDate  time.Time
+	Birthday   time.Time
+}
+
+// IsBirthCertificateSeriesValid проверяет серию свидетельства о рождении. Римская часть должна быть
+// записана по правилам: "IV", а не "IIII", латинскими буквами I, V, X, L, C, D, M.
+func IsBirthCertificateSeriesValid(series string) error {
+	if series == "" {
+		return ErrEmptyBirthCertificateSeries
+	}
+
+	formatValid, numeralValid := romanSeriesValid(series)
+	if !formatValid {
+		return ErrInvalidBirthCertificateSeries
+	}
+	if !numeralValid {
+		return ErrInvalidRomanNumeral
+	}
+	return nil
+}
+
+// romanSeriesValid проверяет формат серии "IV-МЮ" и отдельно римское число в ней
+func romanSeriesValid(series string) (formatValid, numeralValid bool) {
+	match := romanSeriesRegexp.FindStringSubmatch(series)
+	if match == nil {
+		return false, false
+	}
+	_, numeralValid = parseRomanNumeral(match[1])
+	return true, numeralValid
+}
+
+func IsBirthCertificateNumberValid(number string) error {
+	if number == "" {
+		return ErrEmptyBirthCertificateNumber
+	}
+	if !birthCertificateNumberRegexp.MatchString(number) {
+		return ErrInvalidBirthCertificateNumber
+	}
+	return nil
+}
+
+// IsBirthCertificateIssueDateValid проверяет, что свидетельство выдано не раньше рождения и не в будущем
+func IsBirthCertificateIssueDateValid(issueDate, birthday, checkDate time.Time) error {
+	if issueDate.IsZero() {
+		return ErrEmptyIssueDate
+	}
+	if birthday.IsZero() {
+		return ErrEmptyBirthday
+	}
+	if civilDate(issueDate).Before(civilDate(birthday)) {
+		return ErrIssueDateBeforeBirthday
+	}
+	if issueDate.After(checkDate) {
+		return ErrIssueDatePassportInFuture
+	}
+	return nil
+}
+
+var romanNumerals = []struct {
+	value  int
+	symbol string
+}{
+	{1000, "M"}, {900, "CM"}, {500, "D"}, {400, "CD"}, {100, "C"}, {90, "XC"},
+	{50, "L"}, {40, "XL"}, {10, "X"}, {9, "IX"}, {5, "V"}, {4, "IV"}, {1, "I"},
+}
+
+// parseRomanNumeral разбирает римское число от 1 до 3999 в канонической записи
+func parseRomanNumeral(s string) (int, bool) {
+	value := 0
+	rest := s
+	for _, numeral := range romanNumerals {
+		for strings.HasPrefix(rest, numeral.symbol) {
+			value += numeral.value
+			rest = rest[len(numeral.symbol):]
+		}
+	}
+	if rest != "" || value == 0 || value > 3999 {
+		return 0, false
+	}
+	// Жадный разбор принимает и неканонические записи вроде "IIII", поэтому сверяем с обратным преобразованием
+	return value, formatRomanNumeral(value) == s
+}
+
+func formatRomanNumeral(value int) string {
+	var b strings.Builder
+	for _, numeral := range romanNumerals {
+		for value >= numeral.value {
+			b.WriteString(numeral.symbol)
+			value -= numeral.value
+		}
+	}
+	return b.String()
+}
+
+// Validate проверяет все поля свидетельства о рождении на дату checkDate и возвращает все найденные ошибки
+func (c BirthCertificate) Validate(checkDate time.Time) error {
+	return c.Check(checkDate).Err()
+}
+
+// Check как Validate, но возвращает ValidationResult
+func (c BirthCertificate) Check(checkDate time.Time) ValidationResult {
+	return defaultValidator.checkBirthCertificate(c, checkDate)
+}
+
+// ValidateBirthCertificate проверяет свидетельство о рождении на текущую дату
+func (v *Validator) ValidateBirthCertificate(c BirthCertificate) error {
+	return v.checkBirthCertificate(c, v.clock.Now()).Err()
+}
+
+func (v *Validator) checkBirthCertificate(c BirthCertificate, checkDate time.Time) ValidationResult {
+	var errs fieldErrors
+
+	errs.check(FieldLastName, c.LastName, v.IsPassportLastNameValid(c.LastName))
+	errs.check(FieldFirstName, c.FirstName, v.IsPassportFirstNameValid(c.FirstName))
+	errs.check(FieldMiddleName, c.MiddleName, v.IsPassportMiddleNameValid(c.MiddleName))
+	errs.check(FieldSeries, c.Series, IsBirthCertificateSeriesValid(c.Series))
+	errs.check(FieldNumber, c.Number, IsBirthCertificateNumberValid(c.Number))
+
+	errs.checkIssueDate(c.IssueDate, IsBirthCertificateIssueDateValid(c.IssueDate, c.Birthday, checkDate))
+
+	return ValidationResult{CheckDate: checkDate, Errors: ValidationErrors(errs)}
+}
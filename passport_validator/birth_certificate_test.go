@@ -0,0 +1,93 @@
+package passport_validator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_IsBirthCertificateSeriesValid(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		series  string
+		wantErr error
+	}{
+		"valid":                   {series: "IV-МЮ"},
+		"valid long numeral":      {series: "XXVIII-АГ"},
+		"valid with Ё":            {series: "II-ЁЖ"},
+		"empty":                   {series: "", wantErr: ErrEmptyBirthCertificateSeries},
+		"non canonical numeral":   {series: "IIII-МЮ", wantErr: ErrInvalidRomanNumeral},
+		"subtractive not allowed": {series: "IC-МЮ", wantErr: ErrInvalidRomanNumeral},
+		"cyrillic numeral":        {series: "ХI-МЮ", wantErr: ErrInvalidBirthCertificateSeries},
+		"no hyphen":               {series: "IVМЮ", wantErr: ErrInvalidBirthCertificateSeries},
+		"latin letters":           {series: "IV-MY", wantErr: ErrInvalidBirthCertificateSeries},
+		"lowercase letters":       {series: "IV-мю", wantErr: ErrInvalidBirthCertificateSeries},
+		"three letters":           {series: "IV-МЮА", wantErr: ErrInvalidBirthCertificateSeries},
+		"passport series":         {series: "4617", wantErr: ErrInvalidBirthCertificateSeries},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := IsBirthCertificateSeriesValid(tt.series)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_BirthCertificateValidate(t *testing.T) {
+	t.Parallel()
+
+	checkDate := time.Date(2024, 2, 27, 0, 0, 0, 0, time.UTC)
+	valid := BirthCertificate{
+		LastName:   "Иванова",
+		FirstName:  "Мария",
+		MiddleName: "Ивановна",
+		Series:     "IV-МЮ",
+		Number:     "123456",
+		IssueDate:  time.Date(2015, 3, 10, 0, 0, 0, 0, time.UTC),
+		Birthday:   time.Date(2015, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	testCases := map[string]documentCase[BirthCertificate]{
+		"valid": {
+			modify: func(c *BirthCertificate) {},
+		},
+		"issued on birthday": {
+			modify: func(c *BirthCertificate) { c.IssueDate = c.Birthday },
+		},
+		"invalid number": {
+			modify:    func(c *BirthCertificate) { c.Number = "12345" },
+			wantField: FieldNumber,
+			wantErr:   ErrInvalidBirthCertificateNumber,
+		},
+		"issued before birthday": {
+			modify:    func(c *BirthCertificate) { c.IssueDate = time.Date(2015, 2, 1, 0, 0, 0, 0, time.UTC) },
+			wantField: FieldIssueDate,
+			wantErr:   ErrIssueDateBeforeBirthday,
+		},
+		"issued in future": {
+			modify:    func(c *BirthCertificate) { c.IssueDate = time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC) },
+			wantField: FieldIssueDate,
+			wantErr:   ErrIssueDatePassportInFuture,
+		},
+		"empty birthday": {
+			modify:    func(c *BirthCertificate) { c.Birthday = time.Time{} },
+			wantField: FieldBirthday,
+			wantErr:   ErrEmptyBirthday,
+		},
+	}
+
+	runDocumentCases(t, func() BirthCertificate { return valid }, checkDate, testCases)
+}
@@ -0,0 +1,134 @@
+package passport_validator
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var ErrUnsupportedDocument = errors.New("unsupported document type")
+
+// DocumentType тип документа в payload {"type": "...", "fields": {...}}
+type DocumentType string
+
+const (
+	DocumentPassport                 DocumentType = "passport"
+	DocumentForeignPassport          DocumentType = "foreign_passport"
+	DocumentBirthCertificate         DocumentType = "birth_certificate"
+	DocumentSovietPassport           DocumentType = "soviet_passport"
+	DocumentTemporaryID              DocumentType = "temporary_id"
+	DocumentResidencePermit          DocumentType = "residence_permit"
+	DocumentTemporaryResidencePermit DocumentType = "temporary_residence_permit"
+	DocumentMilitaryID               DocumentType = "military_id"
+	DocumentDriverLicense            DocumentType = "driver_license"
+)
+
+// Document документ любого типа. Встроенные документы пакета оборачиваются в Document через NewDocument,
+// собственные типы документов регистрируются в DocumentRegistry.
+type Document interface {
+	Type() DocumentType
+	// Fields поля документа по путям ValidationError.Field, даты в формате 2006-01-02
+	Fields() map[string]string
+	// Validate проверяет документ на дату checkDate и возвращает ValidationErrors или ошибку ctx
+	Validate(ctx context.Context, checkDate time.Time) error
+}
+
+// CheckDocument проверяет любой документ пакета: Passport, ForeignPassport, BirthCertificate,
+// SovietPassport, TemporaryID, ResidencePermit, TemporaryResidencePermit, MilitaryID или DriverLicense
+func CheckDocument(doc any, checkDate time.Time) (ValidationResult, error) {
+	return defaultValidator.checkDocument(doc, checkDate)
+}
+
+// ValidateDocument как CheckDocument, но возвращает только ошибки проверки
+func ValidateDocument(doc any, checkDate time.Time) error {
+	result, err := CheckDocument(doc, checkDate)
+	if err != nil {
+		return err
+	}
+	return result.Err()
+}
+
+// CheckDocument проверяет документ на текущую дату с настройками валидатора
+func (v *Validator) CheckDocument(doc any) (ValidationResult, error) {
+	return v.checkDocument(doc, v.clock.Now())
+}
+
+// ValidateDocument проверяет документ на текущую дату с настройками валидатора
+func (v *Validator) ValidateDocument(doc any) error {
+	result, err := v.CheckDocument(doc)
+	if err != nil {
+		return err
+	}
+	return result.Err()
+}
+
+func (v *Validator) checkDocument(doc any, checkDate time.Time) (ValidationResult, error) {
+	switch doc := doc.(type) {
+	case Passport:
+		return v.checkPassport(doc, checkDate), nil
+	case ForeignPassport:
+		return v.checkForeignPassport(doc, checkDate), nil
+	case BirthCertificate:
+		return v.checkBirthCertificate(doc, checkDate), nil
+	case SovietPassport:
+		return v.checkSovietPassport(doc, checkDate), nil
+	case TemporaryID:
+		return v.checkTemporaryID(doc, checkDate), nil
+	case ResidencePermit:
+		return v.checkResidencePermit(doc, checkDate), nil
+	case TemporaryResidencePermit:
+		return v.checkTemporaryResidencePermit(doc, checkDate), nil
+	case MilitaryID:
+		return v.checkMilitaryID(doc, checkDate), nil
+	case DriverLicense:
+		return v.checkDriverLicense(doc, checkDate), nil
+	default:
+		return ValidationResult{}, ErrUnsupportedDocument
+	}
+}
+
+// NewDocument оборачивает встроенный документ пакета (см. CheckDocument) в Document
+func NewDocument(doc any) (Document, error) {
+	return defaultValidator.NewDocument(doc)
+}
+
+// NewDocument оборачивает встроенный документ пакета в Document, который проверяется этим валидатором
+func (v *Validator) NewDocument(doc any) (Document, error) {
+	docType, fields, err := encodeDocument(doc)
+	if err != nil {
+		return nil, err
+	}
+	return &builtinDocument{docType: docType, fields: fields, value: doc, validator: v}, nil
+}
+
+// builtinDocument адаптер встроенных документов пакета к Document
+type builtinDocument struct {
+	docType DocumentType
+	fields  map[string]string
+	// value Passport, ForeignPassport и другие структуры, которые принимает checkDocument
+	value     any
+	validator *Validator
+}
+
+func (d *builtinDocument) Type() DocumentType {
+	return d.docType
+}
+
+func (d *builtinDocument) Fields() map[string]string {
+	fields := make(map[string]string, len(d.fields))
+	for field, value := range d.fields {
+		fields[field] = value
+	}
+	return fields
+}
+
+func (d *builtinDocument) Validate(ctx context.Context, checkDate time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	result, err := d.validator.checkDocument(d.value, checkDate)
+	if err != nil {
+		return err
+	}
+	return result.Err()
+}
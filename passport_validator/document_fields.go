@@ -0,0 +1,310 @@
+package passport_validator
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidDateFormat    = errors.New("date is not in YYYY-MM-DD format")
+	ErrUnknownDocumentField = errors.New("unknown document field")
+)
+
+// Пути полей, которые передаются в Document.Fields, но не проверяются
+const (
+	FieldIssuedBy     = "issued_by"
+	FieldPlaceOfBirth = "place_of_birth"
+	FieldSex          = "sex"
+)
+
+// fieldBinder связывает поля структуры документа с Document.Fields: fieldDecoder заполняет
+// структуру из полей, fieldEncoder собирает поля из структуры. Так каждое поле описывается один раз.
+type fieldBinder interface {
+	string(field string, value *string)
+	// choice как string, но непустое значение должно быть одним из options, иначе при разборе ошибка err
+	choice(field string, value *string, options []string, err error)
+	date(field string, value *time.Time)
+	dates(field string, value *[]time.Time)
+}
+
+// fieldDecoder заполняет структуру документа из полей, собирая ошибки разбора
+type fieldDecoder struct {
+	fields map[string]string
+	used   map[string]bool
+	errs   ValidationErrors
+}
+
+func newFieldDecoder(fields map[string]string) *fieldDecoder {
+	return &fieldDecoder{fields: fields, used: make(map[string]bool, len(fields))}
+}
+
+func (d *fieldDecoder) string(field string, value *string) {
+	d.used[field] = true
+	*value = d.fields[field]
+}
+
+func (d *fieldDecoder) choice(field string, value *string, options []string, err error) {
+	d.string(field, value)
+	if *value == "" {
+		return
+	}
+	for _, option := range options {
+		if *value == option {
+			return
+		}
+	}
+	d.errs = append(d.errs, newValidationError(field, *value, err))
+}
+
+func (d *fieldDecoder) date(field string, value *time.Time) {
+	var s string
+	d.string(field, &s)
+	date, err := parseOptionalDate(s)
+	if err != nil {
+		d.errs = append(d.errs, newValidationError(field, s, ErrInvalidDateFormat))
+		return
+	}
+	*value = date
+}
+
+func (d *fieldDecoder) dates(field string, value *[]time.Time) {
+	var s string
+	d.string(field, &s)
+	if s == "" {
+		return
+	}
+	for _, part := range strings.Split(s, ",") {
+		date, err := parseOptionalDate(strings.TrimSpace(part))
+		if err != nil || date.IsZero() {
+			d.errs = append(d.errs, newValidationError(field, s, ErrInvalidDateFormat))
+			return
+		}
+		*value = append(*value, date)
+	}
+}
+
+// err ошибки разбора и поля, которых нет в документе, в порядке полей
+func (d *fieldDecoder) err() error {
+	unknown := make([]string, 0)
+	for field := range d.fields {
+		if !d.used[field] {
+			unknown = append(unknown, field)
+		}
+	}
+	sort.Strings(unknown)
+	for _, field := range unknown {
+		d.errs = append(d.errs, newValidationError(field, d.fields[field], ErrUnknownDocumentField))
+	}
+	return d.errs.err()
+}
+
+// fieldEncoder собирает поля документа, пустые значения пропускаются
+type fieldEncoder map[string]string
+
+func (e fieldEncoder) string(field string, value *string) {
+	if *value != "" {
+		e[field] = *value
+	}
+}
+
+func (e fieldEncoder) choice(field string, value *string, _ []string, _ error) {
+	e.string(field, value)
+}
+
+func (e fieldEncoder) date(field string, value *time.Time) {
+	if !value.IsZero() {
+		e[field] = formatDate(*value)
+	}
+}
+
+func (e fieldEncoder) dates(field string, value *[]time.Time) {
+	if len(*value) == 0 {
+		return
+	}
+	dates := make([]string, 0, len(*value))
+	for _, date := range *value {
+		dates = append(dates, formatDate(date))
+	}
+	e[field] = strings.Join(dates, ",")
+}
+
+// builtinDecoders создают встроенный документ по типу из полей
+var builtinDecoders = map[DocumentType]func(b fieldBinder) any{
+	DocumentPassport:                 func(b fieldBinder) any { var p Passport; bindPassport(b, &p); return p },
+	DocumentForeignPassport:          func(b fieldBinder) any { var p ForeignPassport; bindForeignPassport(b, &p); return p },
+	DocumentBirthCertificate:         func(b fieldBinder) any { var c BirthCertificate; bindBirthCertificate(b, &c); return c },
+	DocumentSovietPassport:           func(b fieldBinder) any { var p SovietPassport; bindSovietPassport(b, &p); return p },
+	DocumentTemporaryID:              func(b fieldBinder) any { var id TemporaryID; bindTemporaryID(b, &id); return id },
+	DocumentResidencePermit:          func(b fieldBinder) any { var p ResidencePermit; bindResidencePermit(b, &p); return p },
+	DocumentTemporaryResidencePermit: func(b fieldBinder) any { var p TemporaryResidencePermit; bindTemporaryResidencePermit(b, &p); return p },
+	DocumentMilitaryID:               func(b fieldBinder) any { var m MilitaryID; bindMilitaryID(b, &m); return m },
+	DocumentDriverLicense:            func(b fieldBinder) any { var l DriverLicense; bindDriverLicense(b, &l); return l },
+}
+
+// decodeDocument создает встроенный документ типа docType из полей
+func decodeDocument(docType DocumentType, fields map[string]string) (any, error) {
+	decode, ok := builtinDecoders[docType]
+	if !ok {
+		return nil, ErrUnknownDocumentType
+	}
+	d := newFieldDecoder(fields)
+	doc := decode(d)
+	if err := d.err(); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// encodeDocument тип и поля встроенного документа
+func encodeDocument(doc any) (DocumentType, map[string]string, error) {
+	e := fieldEncoder{}
+	var docType DocumentType
+	switch doc := doc.(type) {
+	case Passport:
+		docType = DocumentPassport
+		bindPassport(e, &doc)
+	case ForeignPassport:
+		docType = DocumentForeignPassport
+		bindForeignPassport(e, &doc)
+	case BirthCertificate:
+		docType = DocumentBirthCertificate
+		bindBirthCertificate(e, &doc)
+	case SovietPassport:
+		docType = DocumentSovietPassport
+		bindSovietPassport(e, &doc)
+	case TemporaryID:
+		docType = DocumentTemporaryID
+		bindTemporaryID(e, &doc)
+	case ResidencePermit:
+		docType = DocumentResidencePermit
+		bindResidencePermit(e, &doc)
+	case TemporaryResidencePermit:
+		docType = DocumentTemporaryResidencePermit
+		bindTemporaryResidencePermit(e, &doc)
+	case MilitaryID:
+		docType = DocumentMilitaryID
+		bindMilitaryID(e, &doc)
+	case DriverLicense:
+		docType = DocumentDriverLicense
+		bindDriverLicense(e, &doc)
+	default:
+		return "", nil, ErrUnsupportedDocument
+	}
+	return docType, e, nil
+}
+
+func bindNames(b fieldBinder, lastName, firstName, middleName *string) {
+	b.string(FieldLastName, lastName)
+	b.string(FieldFirstName, firstName)
+	if middleName != nil {
+		b.string(FieldMiddleName, middleName)
+	}
+}
+
+func bindPassport(b fieldBinder, p *Passport) {
+	bindNames(b, &p.LastName, &p.FirstName, &p.MiddleName)
+	b.string(FieldSeries, &p.Series)
+	b.string(FieldNumber, &p.Number)
+	b.date(FieldIssueDate, &p.IssueDate)
+	b.date(FieldBirthday, &p.Birthday)
+	b.string(FieldIssuerCode, &p.IssuerCode)
+	b.string(FieldIssuedBy, &p.IssuedBy)
+	b.string(FieldPlaceOfBirth, &p.PlaceOfBirth)
+	sex := string(p.Sex)
+	b.string(FieldSex, &sex)
+	p.Sex = Sex(sex)
+}
+
+func bindForeignPassport(b fieldBinder, p *ForeignPassport) {
+	bindNames(b, &p.LastName, &p.FirstName, nil)
+	b.string(FieldSeries, &p.Series)
+	b.string(FieldNumber, &p.Number)
+	// Вид паспорта передается названием: "old" или "biometric", неизвестное название — ошибка разбора
+	passportTypes := []ForeignPassportType{ForeignPassportOld, ForeignPassportBiometric}
+	names := make([]string, 0, len(passportTypes))
+	for _, t := range passportTypes {
+		names = append(names, t.String())
+	}
+	passportType := ""
+	if p.Type.ValidityYears() != 0 {
+		passportType = p.Type.String()
+	}
+	b.choice(FieldDocumentType, &passportType, names, ErrInvalidForeignPassportType)
+	p.Type = 0
+	for _, t := range passportTypes {
+		if t.String() == passportType {
+			p.Type = t
+		}
+	}
+	b.date(FieldIssueDate, &p.IssueDate)
+	b.date(FieldExpiryDate, &p.ExpiryDate)
+	b.date(FieldBirthday, &p.Birthday)
+}
+
+func bindBirthCertificate(b fieldBinder, c *BirthCertificate) {
+	bindNames(b, &c.LastName, &c.FirstName, &c.MiddleName)
+	b.string(FieldSeries, &c.Series)
+	b.string(FieldNumber, &c.Number)
+	b.date(FieldIssueDate, &c.IssueDate)
+	b.date(FieldBirthday, &c.Birthday)
+}
+
+func bindMilitaryID(b fieldBinder, m *MilitaryID) {
+	bindNames(b, &m.LastName, &m.FirstName, &m.MiddleName)
+	b.string(FieldSeries, &m.Series)
+	b.string(FieldNumber, &m.Number)
+	b.date(FieldIssueDate, &m.IssueDate)
+	b.date(FieldBirthday, &m.Birthday)
+}
+
+func bindDriverLicense(b fieldBinder, l *DriverLicense) {
+	bindNames(b, &l.LastName, &l.FirstName, &l.MiddleName)
+	b.string(FieldSeries, &l.Series)
+	b.string(FieldNumber, &l.Number)
+	b.date(FieldIssueDate, &l.IssueDate)
+	b.date(FieldExpiryDate, &l.ExpiryDate)
+	b.date(FieldBirthday, &l.Birthday)
+}
+
+func bindSovietPassport(b fieldBinder, p *SovietPassport) {
+	bindNames(b, &p.LastName, &p.FirstName, &p.MiddleName)
+	b.string(FieldSeries, &p.Series)
+	b.string(FieldNumber, &p.Number)
+	b.date(FieldIssueDate, &p.IssueDate)
+	b.date(FieldBirthday, &p.Birthday)
+}
+
+func bindTemporaryID(b fieldBinder, id *TemporaryID) {
+	bindNames(b, &id.LastName, &id.FirstName, &id.MiddleName)
+	b.string(FieldNumber, &id.Number)
+	b.string(FieldIssuerCode, &id.IssuerCode)
+	b.date(FieldIssueDate, &id.IssueDate)
+	b.date(FieldBirthday, &id.Birthday)
+	b.date(FieldExpiryDate, &id.ExpiryDate)
+	// Даты продления через запятую: "2024-03-15,2024-04-15"
+	b.dates(FieldExtensions, &id.Extensions)
+}
+
+func bindResidencePermit(b fieldBinder, p *ResidencePermit) {
+	bindNames(b, &p.LastName, &p.FirstName, &p.MiddleName)
+	b.string(FieldLatinLastName, &p.LatinLastName)
+	b.string(FieldLatinFirstName, &p.LatinFirstName)
+	b.string(FieldSeries, &p.Series)
+	b.string(FieldNumber, &p.Number)
+	b.date(FieldIssueDate, &p.IssueDate)
+	b.date(FieldExpiryDate, &p.ExpiryDate)
+	b.date(FieldBirthday, &p.Birthday)
+}
+
+func bindTemporaryResidencePermit(b fieldBinder, p *TemporaryResidencePermit) {
+	bindNames(b, &p.LastName, &p.FirstName, &p.MiddleName)
+	b.string(FieldLatinLastName, &p.LatinLastName)
+	b.string(FieldLatinFirstName, &p.LatinFirstName)
+	// Номер решения проверяется как номер документа, поэтому и передается в поле number
+	b.string(FieldNumber, &p.DecisionNumber)
+	b.date(FieldIssueDate, &p.IssueDate)
+	b.date(FieldExpiryDate, &p.ExpiryDate)
+	b.date(FieldBirthday, &p.Birthday)
+}
@@ -0,0 +1,117 @@
+package passport_validator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+var (
+	ErrInvalidDocumentPayload = errors.New("document payload is not {\"type\": ..., \"fields\": {...}} JSON")
+	ErrUnknownDocumentType    = errors.New("document type is not registered")
+)
+
+// DocumentFactory создает документ из полей payload. Ошибки в отдельных полях возвращаются как ValidationErrors.
+type DocumentFactory func(fields map[string]string) (Document, error)
+
+// DocumentRegistry фабрики документов по типу. Безопасен для конкурентного использования.
+type DocumentRegistry struct {
+	mu        sync.RWMutex
+	factories map[DocumentType]DocumentFactory
+}
+
+// documentPayload формат документа, который принимают сервисы: {"type": "passport", "fields": {"series": "4617"}}
+type documentPayload struct {
+	Type   DocumentType      `json:"type"`
+	Fields map[string]string `json:"fields"`
+}
+
+// NewDocumentRegistry создает реестр со встроенными документами пакета, которые проверяются валидатором v
+func NewDocumentRegistry(v *Validator) *DocumentRegistry {
+	r := &DocumentRegistry{factories: make(map[DocumentType]DocumentFactory, len(builtinDecoders))}
+	for docType := range builtinDecoders {
+		docType := docType
+		r.factories[docType] = func(fields map[string]string) (Document, error) {
+			doc, err := decodeDocument(docType, fields)
+			if err != nil {
+				return nil, err
+			}
+			return v.NewDocument(doc)
+		}
+	}
+	return r
+}
+
+// defaultDocumentRegistry используется функциями RegisterDocumentType и ParseDocument
+var defaultDocumentRegistry = NewDocumentRegistry(defaultValidator)
+
+// Register добавляет тип документа или заменяет фабрику уже зарегистрированного типа
+func (r *DocumentRegistry) Register(docType DocumentType, factory DocumentFactory) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.factories[docType] = factory
+}
+
+// Types зарегистрированные типы документов в алфавитном порядке
+func (r *DocumentRegistry) Types() []DocumentType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	types := make([]DocumentType, 0, len(r.factories))
+	for docType := range r.factories {
+		types = append(types, docType)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+// New создает документ типа docType из полей
+func (r *DocumentRegistry) New(docType DocumentType, fields map[string]string) (Document, error) {
+	r.mu.RLock()
+	factory, ok := r.factories[docType]
+	r.mu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%q: %w", docType, ErrUnknownDocumentType)
+	}
+	return factory(fields)
+}
+
+// Parse создает документ из JSON {"type": "...", "fields": {...}}. Значения полей — строки, даты в формате 2006-01-02.
+func (r *DocumentRegistry) Parse(payload []byte) (Document, error) {
+	var p documentPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDocumentPayload, err)
+	}
+	return r.New(p.Type, p.Fields)
+}
+
+// Validate разбирает payload и проверяет документ на дату checkDate. Ошибки разбора полей
+// и ошибки проверки возвращаются одинаково, как ValidationErrors.
+func (r *DocumentRegistry) Validate(ctx context.Context, payload []byte, checkDate time.Time) error {
+	doc, err := r.Parse(payload)
+	if err != nil {
+		return err
+	}
+	return doc.Validate(ctx, checkDate)
+}
+
+// RegisterDocumentType добавляет тип документа в реестр по умолчанию, см. DocumentRegistry.Register
+func RegisterDocumentType(docType DocumentType, factory DocumentFactory) {
+	defaultDocumentRegistry.Register(docType, factory)
+}
+
+// ParseDocument создает документ из JSON реестром по умолчанию, см. DocumentRegistry.Parse
+func ParseDocument(payload []byte) (Document, error) {
+	return defaultDocumentRegistry.Parse(payload)
+}
+
+// ValidateDocumentPayload разбирает и проверяет документ реестром по умолчанию, см. DocumentRegistry.Validate
+func ValidateDocumentPayload(ctx context.Context, payload []byte, checkDate time.Time) error {
+	return defaultDocumentRegistry.Validate(ctx, payload, checkDate)
+}
@@ -0,0 +1,157 @@
+package passport_validator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateDocumentPayload(t *testing.T) {
+	t.Parallel()
+
+	checkDate := time.Date(2024, 2, 27, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		payload   string
+		wantField string
+		wantValue string
+		wantErr   error
+	}{
+		"passport": {
+			payload: `{"type": "passport", "fields": {"last_name": "Иванов", "first_name": "Иван", "series": "4617",
+				"number": "123456", "issue_date": "2017-02-20", "birthday": "1997-02-20", "issuer_code": "500-001"}}`,
+		},
+		"foreign passport": {
+			payload: `{"type": "foreign_passport", "fields": {"last_name": "IVANOV", "first_name": "IVAN", "series": "75",
+				"number": "1234567", "document_type": "biometric", "issue_date": "2019-03-05", "expiry_date": "2029-03-05",
+				"birthday": "1990-07-01"}}`,
+		},
+		"birth certificate": {
+			payload: `{"type": "birth_certificate", "fields": {"last_name": "Иванова", "first_name": "Анна", "series": "IV-МЮ",
+				"number": "123456", "issue_date": "2015-06-01", "birthday": "2015-05-20"}}`,
+		},
+		"validation error": {
+			payload: `{"type": "passport", "fields": {"last_name": "Иванов", "first_name": "Иван", "series": "4617",
+				"number": "12345", "issue_date": "2017-02-20", "birthday": "1997-02-20", "issuer_code": "500-001"}}`,
+			wantField: FieldNumber,
+			wantErr:   ErrInvalidPassportNumber,
+		},
+		"foreign passport unknown type": {
+			payload: `{"type": "foreign_passport", "fields": {"last_name": "IVANOV", "first_name": "IVAN", "series": "75",
+				"number": "1234567", "document_type": "diplomatic", "issue_date": "2019-03-05", "expiry_date": "2029-03-05",
+				"birthday": "1990-07-01"}}`,
+			wantField: FieldDocumentType,
+			wantValue: "diplomatic",
+			wantErr:   ErrInvalidForeignPassportType,
+		},
+		"invalid date": {
+			payload: `{"type": "passport", "fields": {"last_name": "Иванов", "first_name": "Иван", "series": "4617",
+				"number": "123456", "issue_date": "20.02.2017", "birthday": "1997-02-20", "issuer_code": "500-001"}}`,
+			wantField: FieldIssueDate,
+			wantErr:   ErrInvalidDateFormat,
+		},
+		"unknown field": {
+			payload: `{"type": "birth_certificate", "fields": {"last_name": "Иванова", "first_name": "Анна", "series": "IV-МЮ",
+				"number": "123456", "issue_date": "2015-06-01", "birthday": "2015-05-20", "issuer_code": "500-001"}}`,
+			wantField: FieldIssuerCode,
+			wantErr:   ErrUnknownDocumentField,
+		},
+		"unknown type": {
+			payload: `{"type": "snils", "fields": {"number": "112-233-445 95"}}`,
+			wantErr: ErrUnknownDocumentType,
+		},
+		"not json": {
+			payload: `passport 4617 123456`,
+			wantErr: ErrInvalidDocumentPayload,
+		},
+		"number field": {
+			payload: `{"type": "passport", "fields": {"number": 123456}}`,
+			wantErr: ErrInvalidDocumentPayload,
+		},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateDocumentPayload(context.Background(), []byte(tt.payload), checkDate)
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantField != "" {
+				var errs ValidationErrors
+				require.ErrorAs(t, err, &errs)
+				require.Len(t, errs, 1)
+				assert.Equal(t, tt.wantField, errs[0].Field)
+				if tt.wantValue != "" {
+					assert.Equal(t, tt.wantValue, errs[0].Value)
+				}
+			}
+		})
+	}
+}
+
+func Test_DocumentValidateCanceled(t *testing.T) {
+	t.Parallel()
+
+	doc, err := NewDocument(validPassport())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err = doc.Validate(ctx, time.Date(2024, 2, 27, 0, 0, 0, 0, time.UTC))
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+// snils пример собственного типа документа
+type snils struct {
+	number string
+}
+
+var errInvalidSNILS = errors.New("invalid SNILS")
+
+func (s snils) Type() DocumentType {
+	return "snils"
+}
+
+func (s snils) Fields() map[string]string {
+	return map[string]string{FieldNumber: s.number}
+}
+
+func (s snils) Validate(ctx context.Context, checkDate time.Time) error {
+	if len(s.number) != 14 {
+		return ValidationErrors{newValidationError(FieldNumber, s.number, errInvalidSNILS)}
+	}
+	return nil
+}
+
+func Test_DocumentRegistryCustomType(t *testing.T) {
+	t.Parallel()
+
+	r := NewDocumentRegistry(NewValidator())
+	r.Register("snils", func(fields map[string]string) (Document, error) {
+		return snils{number: fields[FieldNumber]}, nil
+	})
+
+	assert.Contains(t, r.Types(), DocumentType("snils"))
+	assert.Contains(t, r.Types(), DocumentPassport)
+
+	checkDate := time.Date(2024, 2, 27, 0, 0, 0, 0, time.UTC)
+	err := r.Validate(context.Background(), []byte(`{"type": "snils", "fields": {"number": "112-233-445 95"}}`), checkDate)
+	require.NoError(t, err)
+
+	err = r.Validate(context.Background(), []byte(`{"type": "snils", "fields": {"number": "11223344595"}}`), checkDate)
+	assert.ErrorIs(t, err, errInvalidSNILS)
+
+	// Реестр по умолчанию не меняется
+	_, err = ParseDocument([]byte(`{"type": "snils", "fields": {}}`))
+	assert.ErrorIs(t, err, ErrUnknownDocumentType)
+}
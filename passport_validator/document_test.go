@@ -0,0 +1,143 @@
+package passport_validator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CheckDocument(t *testing.T) {
+	t.Parallel()
+
+	checkDate := time.Date(2024, 2, 27, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		doc     any
+		wantErr error
+	}{
+		"passport":                   {doc: validPassport()},
+		"foreign passport":           {doc: validForeignPassport()},
+		"residence permit":           {doc: validResidencePermit()},
+		"temporary residence permit": {doc: validTemporaryResidencePermit()},
+		"pointer":                    {doc: &Passport{}, wantErr: ErrUnsupportedDocument},
+		"unsupported":                {doc: "4617 123456", wantErr: ErrUnsupportedDocument},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := CheckDocument(tt.doc, checkDate)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.NoError(t, result.Err())
+			assert.Equal(t, checkDate, result.CheckDate)
+		})
+	}
+}
+
+func Test_ValidatorValidateDocumentUsesOptions(t *testing.T) {
+	t.Parallel()
+
+	id := validTemporaryID()
+	id.ExpiryDate = time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+	now := fixedClock(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+
+	err := NewValidator(WithClock(now)).ValidateDocument(id)
+	assert.ErrorIs(t, err, ErrTemporaryIDValidityTooLong)
+
+	err = NewValidator(WithClock(now), WithTemporaryIDValidity(2)).ValidateDocument(id)
+	assert.NoError(t, err)
+}
+
+func Test_NewDocumentFieldsRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	id := validTemporaryID()
+	id.Extensions = []time.Time{time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)}
+	passport := validPassport()
+	passport.Sex = SexMale
+
+	testCases := map[string]struct {
+		doc      any
+		wantType DocumentType
+	}{
+		"passport":                   {doc: passport, wantType: DocumentPassport},
+		"foreign passport":           {doc: validForeignPassport(), wantType: DocumentForeignPassport},
+		"temporary id":               {doc: id, wantType: DocumentTemporaryID},
+		"residence permit":           {doc: validResidencePermit(), wantType: DocumentResidencePermit},
+		"temporary residence permit": {doc: validTemporaryResidencePermit(), wantType: DocumentTemporaryResidencePermit},
+		"military id":                {doc: validMilitaryID(), wantType: DocumentMilitaryID},
+		"driver license":             {doc: validDriverLicense(), wantType: DocumentDriverLicense},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := NewDocument(tt.doc)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantType, doc.Type())
+
+			decoded, err := decodeDocument(doc.Type(), doc.Fields())
+			require.NoError(t, err)
+			assert.Equal(t, tt.doc, decoded)
+		})
+	}
+}
+
+func Test_NewDocumentUnsupported(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewDocument(&Passport{})
+	assert.ErrorIs(t, err, ErrUnsupportedDocument)
+}
+
+// documentCase вариант документа: modify портит валидный документ, wantErr — ожидаемая ошибка поля wantField.
+// Нулевая checkDate означает дату проверки по умолчанию из runDocumentCases.
+type documentCase[D any] struct {
+	modify    func(d *D)
+	checkDate time.Time
+	wantField string
+	wantErr   error
+}
+
+// runDocumentCases проверяет Validate каждого варианта документа, полученного из valid().
+// Без wantErr документ должен быть действителен, иначе ожидается ровно одна ошибка поля wantField.
+func runDocumentCases[D interface{ Validate(time.Time) error }](t *testing.T, valid func() D, checkDate time.Time, testCases map[string]documentCase[D]) {
+	t.Helper()
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			doc := valid()
+			tt.modify(&doc)
+
+			date := checkDate
+			if !tt.checkDate.IsZero() {
+				date = tt.checkDate
+			}
+
+			err := doc.Validate(date)
+			if tt.wantErr != nil {
+				var errs ValidationErrors
+				require.ErrorAs(t, err, &errs)
+				require.Len(t, errs, 1)
+				assert.Equal(t, tt.wantField, errs[0].Field)
+				assert.ErrorIs(t, errs[0], tt.wantErr)
+
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
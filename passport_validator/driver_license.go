@@ -0,0 +1,238 @@
+package passport_validator
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var (
+	// Серия водительского удостоверения: 4 цифры у бланков с 2011 года, 2 цифры и 2 буквы
+	// кириллицы, совпадающие по начертанию с латинскими, у более старых бланков
+	driverLicenseSeriesRegexp = regexp.MustCompile(`^(\d{4}|\d{2}[АВЕКМНОРСТУХ]{2})$`)
+	// Проверяем что номер водительского удостоверения состоит из 6 цифр
+	driverLicenseNumberRegexp = regexp.MustCompile(`^\d{6}$`)
+)
+
+var (
+	ErrEmptyDriverLicenseSeries    = errors.New("driver license series is empty")
+	ErrInvalidDriverLicenseSeries  = errors.New("driver license series is not 4 digits or 2 digits and 2 Cyrillic letters")
+	ErrEmptyDriverLicenseNumber    = errors.New("driver license number is empty")
+	ErrInvalidDriverLicenseNumber  = errors.New("driver license number is not 6 digits")
+	ErrDriverLicenseIssuedBefore16 = errors.New("driver license issued before sixteenth birthday")
+	ErrDriverLicenseExpiryMismatch = errors.New("expiry date does not match driver license validity")
+	ErrDriverLicenseExpired        = errors.New("driver license expired")
+)
+
+const (
+	// DriverLicenseValidityYears срок действия водительского удостоверения
+	DriverLicenseValidityYears = 10
+	// DriverLicenseMinIssueAge минимальный возраст, с которого выдаются права (категории M, A1)
+	DriverLicenseMinIssueAge = 16
+)
+
+// driverLicenseHomoglyphs латинские буквы, которые путают с буквами серии водительского удостоверения
+var driverLicenseHomoglyphs = map[rune]rune{
+	'A': 'А', 'B': 'В', 'E': 'Е', 'K': 'К', 'M': 'М', 'H': 'Н',
+	'O': 'О', 'P': 'Р', 'C': 'С', 'T': 'Т', 'Y': 'У', 'X': 'Х',
+}
+
+// DriverLicenseExtension автоматическое продление водительских удостоверений, срок действия которых
+// истекает в период с From по To включительно
+type DriverLicenseExtension struct {
+	From time.Time
+	To   time.Time
+	// Years на сколько лет продлевается срок действия, если Until не задан
+	Years int
+	// Until до какого дня включительно продлевается срок действия, нулевая если продление на Years лет
+	Until time.Time
+}
+
+// DefaultDriverLicenseExtensions продления, которые используются по умолчанию: удостоверения,
+// истекшие с 1 февраля 2020 по 15 июля 2021 года, действовали до 15 июля 2021 года
+// (постановление Правительства РФ № 440), а истекающие в 2022–2023 годах продлены на 3 года
+// (постановление Правительства РФ № 353)
+func DefaultDriverLicenseExtensions() []DriverLicenseExtension {
+	return []DriverLicenseExtension{
+		{
+			From:  time.Date(2020, time.February, 1, 0, 0, 0, 0, time.UTC),
+			To:    time.Date(2021, time.July, 15, 0, 0, 0, 0, time.UTC),
+			Until: time.Date(2021, time.July, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			From:  time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+			To:    time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC),
+			Years: 3,
+		},
+	}
+}
+
+// DriverLicense данные водительского удостоверения
+type DriverLicense struct {
+	LastName   string
+	FirstName  string
+	MiddleName string
+	Series     string
+	Number     string
+	IssueDate  time.Time
+	// ExpiryDate срок действия, напечатанный в удостоверении, без учета продлений
+	ExpiryDate time.Time
+	Birthday   time.Time
+}
+
+// NormalizeDriverLicenseSeries убирает пробелы и заменяет латинские буквы одинаковыми по начертанию
+// буквами кириллицы: "77 yk" -> "77УК"
+func NormalizeDriverLicenseSeries(series string) string {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(series) {
+		if unicode.IsSpace(r) {
+			continue
+		}
+		if cyrillic, ok := driverLicenseHomoglyphs[r]; ok {
+			r = cyrillic
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// ParseDriverLicenseNumber разбирает серию и номер, записанные вместе: "99 01 123456" или "77УК123456"
+func ParseDriverLicenseNumber(value string) (series, number string, err error) {
+	value = NormalizeDriverLicenseSeries(value)
+	if value == "" {
+		return "", "", ErrEmptyDriverLicenseSeries
+	}
+	runes := []rune(value)
+	if len(runes) < 4 {
+		return "", "", ErrInvalidDriverLicenseSeries
+	}
+	series, number = string(runes[:4]), string(runes[4:])
+	if err := IsDriverLicenseSeriesValid(series); err != nil {
+		return "", "", err
+	}
+	if err := IsDriverLicenseNumberValid(number); err != nil {
+		return "", "", err
+	}
+	return series, number, nil
+}
+
+// IsDriverLicenseSeriesValid проверяет серию водительского удостоверения. Пробелы и латинские
+// буквы, похожие на буквы кириллицы, допускаются, см. NormalizeDriverLicenseSeries.
+func IsDriverLicenseSeriesValid(series string) error {
+	if series == "" {
+		return ErrEmptyDriverLicenseSeries
+	}
+	if !driverLicenseSeriesRegexp.MatchString(NormalizeDriverLicenseSeries(series)) {
+		return ErrInvalidDriverLicenseSeries
+	}
+	return nil
+}
+
+func IsDriverLicenseNumberValid(number string) error {
+	if number == "" {
+		return ErrEmptyDriverLicenseNumber
+	}
+	if !driverLicenseNumberRegexp.MatchString(number) {
+		return ErrInvalidDriverLicenseNumber
+	}
+	return nil
+}
+
+// DriverLicenseValidUntil последний день действия удостоверения с истекающим expiryDate с учетом продлений
+func DriverLicenseValidUntil(expiryDate time.Time) time.Time {
+	return defaultValidator.DriverLicenseValidUntil(expiryDate)
+}
+
+func (v *Validator) DriverLicenseValidUntil(expiryDate time.Time) time.Time {
+	validUntil := civilDate(expiryDate)
+	for _, extension := range v.driverLicenseExtensions {
+		if validUntil.Before(civilDate(extension.From)) || validUntil.After(civilDate(extension.To)) {
+			continue
+		}
+		if extension.Until.IsZero() {
+			validUntil = validUntil.AddDate(extension.Years, 0, 0)
+		} else if until := civilDate(extension.Until); until.After(validUntil) {
+			validUntil = until
+		}
+	}
+	return validUntil
+}
+
+// IsDriverLicenseExpiryValid проверяет, что срок действия — 10 лет со дня выдачи (на некоторых бланках
+// днем раньше) и с учетом продлений не истек на checkDate
+func IsDriverLicenseExpiryValid(issueDate, expiryDate, checkDate time.Time) error {
+	return defaultValidator.driverLicenseExpiryValid(issueDate, expiryDate, checkDate)
+}
+
+// IsDriverLicenseExpiryValid проверяет срок действия на текущую дату
+func (v *Validator) IsDriverLicenseExpiryValid(issueDate, expiryDate time.Time) error {
+	return v.driverLicenseExpiryValid(issueDate, expiryDate, v.clock.Now())
+}
+
+func (v *Validator) driverLicenseExpiryValid(issueDate, expiryDate, checkDate time.Time) error {
+	if issueDate.IsZero() {
+		return ErrEmptyIssueDate
+	}
+	if expiryDate.IsZero() {
+		return ErrEmptyExpiryDate
+	}
+	if !v.validityMatches(issueDate, expiryDate, DriverLicenseValidityYears) {
+		return ErrDriverLicenseExpiryMismatch
+	}
+	if civilDate(checkDate).After(v.DriverLicenseValidUntil(expiryDate)) {
+		return ErrDriverLicenseExpired
+	}
+	return nil
+}
+
+func (v *Validator) driverLicenseIssueDateValid(issueDate, birthday, checkDate time.Time) error {
+	if issueDate.IsZero() {
+		return ErrEmptyIssueDate
+	}
+	if birthday.IsZero() {
+		return ErrEmptyBirthday
+	}
+	if AgeAt(birthday, issueDate, v.leapDayPolicy).Years < DriverLicenseMinIssueAge {
+		return ErrDriverLicenseIssuedBefore16
+	}
+	if issueDate.After(checkDate) {
+		return ErrIssueDatePassportInFuture
+	}
+	return nil
+}
+
+// Validate проверяет водительское удостоверение на дату checkDate: ФИО, серию бланка, номер,
+// дату выдачи не раньше 16 лет и срок действия с учетом продлений
+func (l DriverLicense) Validate(checkDate time.Time) error {
+	return l.Check(checkDate).Err()
+}
+
+// Check как Validate, но возвращает ValidationResult. Срок действия сверяется с датой выдачи,
+// поэтому без нее не проверяется.
+func (l DriverLicense) Check(checkDate time.Time) ValidationResult {
+	return defaultValidator.checkDriverLicense(l, checkDate)
+}
+
+// ValidateDriverLicense проверяет водительское удостоверение на текущую дату
+func (v *Validator) ValidateDriverLicense(l DriverLicense) error {
+	return v.checkDriverLicense(l, v.clock.Now()).Err()
+}
+
+func (v *Validator) checkDriverLicense(l DriverLicense, checkDate time.Time) ValidationResult {
+	var errs fieldErrors
+
+	errs.check(FieldLastName, l.LastName, v.IsPassportLastNameValid(l.LastName))
+	errs.check(FieldFirstName, l.FirstName, v.IsPassportFirstNameValid(l.FirstName))
+	errs.check(FieldMiddleName, l.MiddleName, v.IsPassportMiddleNameValid(l.MiddleName))
+	errs.check(FieldSeries, l.Series, IsDriverLicenseSeriesValid(l.Series))
+	errs.check(FieldNumber, l.Number, IsDriverLicenseNumberValid(l.Number))
+
+	errs.checkIssueDate(l.IssueDate, v.driverLicenseIssueDateValid(l.IssueDate, l.Birthday, checkDate))
+	if !l.IssueDate.IsZero() {
+		errs.check(FieldExpiryDate, formatDate(l.ExpiryDate), v.driverLicenseExpiryValid(l.IssueDate, l.ExpiryDate, checkDate))
+	}
+
+	return ValidationResult{CheckDate: checkDate, Errors: ValidationErrors(errs)}
+}
@@ -0,0 +1,232 @@
+package passport_validator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validDriverLicense() DriverLicense {
+	return DriverLicense{
+		LastName:   "Сидоров",
+		FirstName:  "Алексей",
+		MiddleName: "Игоревич",
+		Series:     "9901",
+		Number:     "123456",
+		IssueDate:  time.Date(2018, 5, 14, 0, 0, 0, 0, time.UTC),
+		ExpiryDate: time.Date(2028, 5, 14, 0, 0, 0, 0, time.UTC),
+		Birthday:   time.Date(1995, 8, 9, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func Test_IsDriverLicenseSeriesValid(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		series  string
+		wantErr error
+	}{
+		"digits":                     {series: "9901"},
+		"digits with space":          {series: "99 01"},
+		"cyrillic letters":           {series: "77УК"},
+		"latin homoglyphs":           {series: "77 YK"},
+		"lowercase":                  {series: "77 ук"},
+		"empty":                      {series: "", wantErr: ErrEmptyDriverLicenseSeries},
+		"letter without latin pair":  {series: "77ЖК", wantErr: ErrInvalidDriverLicenseSeries},
+		"latin without homoglyph":    {series: "77DK", wantErr: ErrInvalidDriverLicenseSeries},
+		"letters before digits":      {series: "УК77", wantErr: ErrInvalidDriverLicenseSeries},
+		"passport series and number": {series: "4617123456", wantErr: ErrInvalidDriverLicenseSeries},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := IsDriverLicenseSeriesValid(tt.series)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_ParseDriverLicenseNumber(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value      string
+		wantSeries string
+		wantNumber string
+		wantErr    error
+	}{
+		"digits":           {value: "99 01 123456", wantSeries: "9901", wantNumber: "123456"},
+		"without spaces":   {value: "9901123456", wantSeries: "9901", wantNumber: "123456"},
+		"latin homoglyphs": {value: "77 yk 654321", wantSeries: "77УК", wantNumber: "654321"},
+		"short number":     {value: "9901 12345", wantErr: ErrInvalidDriverLicenseNumber},
+		"invalid series":   {value: "77 DK 654321", wantErr: ErrInvalidDriverLicenseSeries},
+		"too short":        {value: "99", wantErr: ErrInvalidDriverLicenseSeries},
+		"series only":      {value: "99 01", wantErr: ErrEmptyDriverLicenseNumber},
+		"empty":            {value: " ", wantErr: ErrEmptyDriverLicenseSeries},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			series, number, err := ParseDriverLicenseNumber(tt.value)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSeries, series)
+			assert.Equal(t, tt.wantNumber, number)
+		})
+	}
+}
+
+func Test_DriverLicenseValidUntil(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expiryDate time.Time
+		opts       []Option
+		want       time.Time
+	}{
+		"before covid extension period": {
+			expiryDate: time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC),
+			want:       time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC),
+		},
+		"first day of covid extension period": {
+			expiryDate: time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC),
+			want:       time.Date(2021, 7, 15, 0, 0, 0, 0, time.UTC),
+		},
+		"last day of covid extension period": {
+			expiryDate: time.Date(2021, 7, 15, 0, 0, 0, 0, time.UTC),
+			want:       time.Date(2021, 7, 15, 0, 0, 0, 0, time.UTC),
+		},
+		"before extension period": {
+			expiryDate: time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC),
+			want:       time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC),
+		},
+		"first day of extension period": {
+			expiryDate: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			want:       time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"last day of extension period": {
+			expiryDate: time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC),
+			want:       time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC),
+		},
+		"after extension period": {
+			expiryDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			want:       time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"without extensions": {
+			expiryDate: time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC),
+			opts:       []Option{WithDriverLicenseExtensions()},
+			want:       time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"custom extension": {
+			expiryDate: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+			opts: []Option{WithDriverLicenseExtensions(DriverLicenseExtension{
+				From:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				To:    time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+				Years: 1,
+			})},
+			want: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"custom extension until date": {
+			expiryDate: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+			opts: []Option{WithDriverLicenseExtensions(DriverLicenseExtension{
+				From:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				To:    time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+				Until: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			})},
+			want: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, NewValidator(tt.opts...).DriverLicenseValidUntil(tt.expiryDate))
+		})
+	}
+}
+
+func Test_DriverLicenseValidate(t *testing.T) {
+	t.Parallel()
+
+	checkDate := time.Date(2024, 2, 27, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]documentCase[DriverLicense]{
+		"valid": {
+			modify: func(l *DriverLicense) {},
+		},
+		"expiry day before anniversary": {
+			modify: func(l *DriverLicense) { l.ExpiryDate = time.Date(2028, 5, 13, 0, 0, 0, 0, time.UTC) },
+		},
+		"old series with homoglyphs": {
+			modify: func(l *DriverLicense) { l.Series = "77 YK" },
+		},
+		"extended": {
+			modify: func(l *DriverLicense) {
+				l.IssueDate = time.Date(2013, 3, 1, 0, 0, 0, 0, time.UTC)
+				l.ExpiryDate = time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+			},
+		},
+		"expired": {
+			modify: func(l *DriverLicense) {
+				l.IssueDate = time.Date(2011, 12, 1, 0, 0, 0, 0, time.UTC)
+				l.ExpiryDate = time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+			},
+			wantField: FieldExpiryDate,
+			wantErr:   ErrDriverLicenseExpired,
+		},
+		"wrong validity": {
+			modify:    func(l *DriverLicense) { l.ExpiryDate = time.Date(2031, 5, 14, 0, 0, 0, 0, time.UTC) },
+			wantField: FieldExpiryDate,
+			wantErr:   ErrDriverLicenseExpiryMismatch,
+		},
+		"empty expiry": {
+			modify:    func(l *DriverLicense) { l.ExpiryDate = time.Time{} },
+			wantField: FieldExpiryDate,
+			wantErr:   ErrEmptyExpiryDate,
+		},
+		"empty number": {
+			modify:    func(l *DriverLicense) { l.Number = "" },
+			wantField: FieldNumber,
+			wantErr:   ErrEmptyDriverLicenseNumber,
+		},
+		"invalid number": {
+			modify:    func(l *DriverLicense) { l.Number = "1234567" },
+			wantField: FieldNumber,
+			wantErr:   ErrInvalidDriverLicenseNumber,
+		},
+		"issued at 15": {
+			modify:    func(l *DriverLicense) { l.Birthday = time.Date(2003, 1, 1, 0, 0, 0, 0, time.UTC) },
+			wantField: FieldIssueDate,
+			wantErr:   ErrDriverLicenseIssuedBefore16,
+		},
+	}
+
+	runDocumentCases(t, validDriverLicense, checkDate, testCases)
+}
+
+func Test_DefaultDriverLicenseExtensionsIsCopy(t *testing.T) {
+	t.Parallel()
+
+	extensions := DefaultDriverLicenseExtensions()
+	extensions[len(extensions)-1].Years = 100
+
+	assert.NotEqual(t, extensions, DefaultDriverLicenseExtensions())
+	assert.Equal(t, time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC), DriverLicenseValidUntil(time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)))
+}
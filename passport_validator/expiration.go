@@ -0,0 +1,121 @@
+package passport_validator
+
+import "time"
+
+// ExpirationStatus состояние срока действия паспорта на дату проверки
+type ExpirationStatus int
+
+const (
+	// ExpirationValid владелец еще не достиг возраста замены
+	ExpirationValid ExpirationStatus = iota
+	// ExpirationGracePeriod возраст замены наступил, но паспорт действителен до окончания льготного срока
+	ExpirationGracePeriod
+	// ExpirationExpired льготный срок закончился, паспорт недействителен
+	ExpirationExpired
+	// ExpirationLifetime паспорт выдан после 45 лет и замене по возрасту не подлежит
+	ExpirationLifetime
+)
+
+func (s ExpirationStatus) String() string {
+	switch s {
+	case ExpirationValid:
+		return "valid"
+	case ExpirationGracePeriod:
+		return "grace_period"
+	case ExpirationExpired:
+		return "expired"
+	case ExpirationLifetime:
+		return "lifetime"
+	default:
+		return "unknown"
+	}
+}
+
+// Expiration сведения о сроке действия паспорта
+type Expiration struct {
+	Status ExpirationStatus
+	// ReplacementAge возраст, в котором паспорт подлежит замене, 0 для бессрочного паспорта
+	ReplacementAge int
+	// ReplacementDate день, когда владельцу исполняется ReplacementAge лет
+	ReplacementDate time.Time
+	// GraceEnd последний день, когда паспорт еще действителен
+	GraceEnd time.Time
+	// DaysRemaining сколько дней осталось до GraceEnd, если паспорт еще действителен
+	DaysRemaining int
+	// DaysOverdue сколько дней прошло после GraceEnd, если паспорт недействителен
+	DaysOverdue int
+	// RuleSet версия набора правил, по которому рассчитан срок, см. RuleSet.Version
+	RuleSet string
+}
+
+// ExpirationInfo рассчитывает срок действия паспорта, выданного issueDate, на дату checkDate
+func ExpirationInfo(issueDate, birthday, checkDate time.Time) (Expiration, error) {
+	return defaultValidator.expirationInfo(issueDate, birthday, checkDate)
+}
+
+// ExpirationInfo рассчитывает срок действия паспорта на текущую дату
+func (v *Validator) ExpirationInfo(issueDate, birthday time.Time) (Expiration, error) {
+	return v.expirationInfo(issueDate, birthday, v.clock.Now())
+}
+
+func (v *Validator) expirationInfo(issueDate, birthday time.Time, checkDate time.Time) (Expiration, error) {
+	if issueDate.IsZero() {
+		return Expiration{}, ErrEmptyIssueDate
+	}
+
+	if birthday.IsZero() {
+		return Expiration{}, ErrEmptyBirthday
+	}
+
+	ruleSet := v.RuleSetAt(issueDate)
+
+	ageAtIssue := AgeAt(birthday, issueDate, v.leapDayPolicy).Years
+	// Проверка на возможность выдачи паспорта до 14 лет
+	if ageAtIssue < ruleSet.MinIssueAge {
+		return Expiration{}, ErrInvalidIssueDateBefore14Birthday
+	}
+
+	// Проверка выдачи паспорта в будущем
+	if issueDate.After(checkDate) {
+		return Expiration{}, ErrIssueDatePassportInFuture
+	}
+
+	// Паспорт меняется в ближайшем возрасте замены после выдачи, после 45 лет — бессрочный
+	replacementAge := 0
+	for _, age := range ruleSet.ReplacementAges {
+		if ageAtIssue < age {
+			replacementAge = age
+			break
+		}
+	}
+	if replacementAge == 0 {
+		return Expiration{Status: ExpirationLifetime, RuleSet: ruleSet.Version}, nil
+	}
+
+	expiration := Expiration{
+		ReplacementAge:  replacementAge,
+		ReplacementDate: AnniversaryDate(birthday, replacementAge, v.leapDayPolicy),
+		GraceEnd:        v.expirationDate(birthday, replacementAge, ruleSet),
+		RuleSet:         ruleSet.Version,
+	}
+
+	// GraceEnd и ReplacementDate — календарные даты, время дня checkDate не учитывается
+	switch checkDay := civilDate(checkDate); {
+	case checkDay.After(expiration.GraceEnd):
+		expiration.Status = ExpirationExpired
+		expiration.DaysOverdue = daysBetween(expiration.GraceEnd, checkDate)
+	case checkDay.Before(expiration.ReplacementDate):
+		expiration.Status = ExpirationValid
+		expiration.DaysRemaining = daysBetween(checkDate, expiration.GraceEnd)
+	default:
+		expiration.Status = ExpirationGracePeriod
+		expiration.DaysRemaining = daysBetween(checkDate, expiration.GraceEnd)
+	}
+
+	return expiration, nil
+}
+
+// expirationDate последний день, когда паспорт еще действителен после достижения возраста age
+func (v *Validator) expirationDate(birthday time.Time, age int, ruleSet RuleSet) time.Time {
+	return AnniversaryDate(birthday, age, v.leapDayPolicy).AddDate(0, 0, ruleSet.GraceDays)
+}
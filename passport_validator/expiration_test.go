@@ -0,0 +1,121 @@
+package passport_validator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ExpirationInfo(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		issueDate time.Time
+		birthday  time.Time
+		checkDate time.Time
+		want      Expiration
+		wantErr   error
+	}{
+		"valid until 20": {
+			issueDate: time.Date(2020, 2, 20, 0, 0, 0, 0, time.UTC),
+			birthday:  time.Date(2006, 2, 20, 0, 0, 0, 0, time.UTC),
+			checkDate: time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC),
+			want: Expiration{
+				Status:          ExpirationValid,
+				ReplacementAge:  20,
+				ReplacementDate: time.Date(2026, 2, 20, 0, 0, 0, 0, time.UTC),
+				GraceEnd:        time.Date(2026, 5, 22, 0, 0, 0, 0, time.UTC),
+				DaysRemaining:   815,
+				RuleSet:         "828-1997",
+			},
+		},
+		"grace period at 45": {
+			issueDate: time.Date(2000, 2, 20, 0, 0, 0, 0, time.UTC),
+			birthday:  time.Date(1979, 2, 16, 0, 0, 0, 0, time.UTC),
+			checkDate: time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC),
+			want: Expiration{
+				Status:          ExpirationGracePeriod,
+				ReplacementAge:  45,
+				ReplacementDate: time.Date(2024, 2, 16, 0, 0, 0, 0, time.UTC),
+				GraceEnd:        time.Date(2024, 5, 17, 0, 0, 0, 0, time.UTC),
+				DaysRemaining:   80,
+				RuleSet:         "828-1997",
+			},
+		},
+		"expired at 20": {
+			issueDate: time.Date(2019, 2, 20, 0, 0, 0, 0, time.UTC),
+			birthday:  time.Date(2000, 01, 01, 0, 0, 0, 0, time.UTC),
+			checkDate: time.Date(2020, 4, 11, 0, 0, 0, 0, time.UTC),
+			want: Expiration{
+				Status:          ExpirationExpired,
+				ReplacementAge:  20,
+				ReplacementDate: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				GraceEnd:        time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC),
+				DaysOverdue:     10,
+				RuleSet:         "828-1997",
+			},
+		},
+		"last day of grace period with time of day": {
+			issueDate: time.Date(2000, 5, 1, 0, 0, 0, 0, time.UTC),
+			birthday:  time.Date(1980, 1, 10, 0, 0, 0, 0, time.UTC),
+			checkDate: time.Date(2025, 4, 11, 12, 0, 0, 0, time.UTC),
+			want: Expiration{
+				Status:          ExpirationGracePeriod,
+				ReplacementAge:  45,
+				ReplacementDate: time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC),
+				GraceEnd:        time.Date(2025, 4, 11, 0, 0, 0, 0, time.UTC),
+				RuleSet:         "828-1997",
+			},
+		},
+		"day before replacement with time of day": {
+			issueDate: time.Date(2000, 5, 1, 0, 0, 0, 0, time.UTC),
+			birthday:  time.Date(1980, 1, 10, 0, 0, 0, 0, time.UTC),
+			checkDate: time.Date(2025, 1, 9, 23, 59, 0, 0, time.UTC),
+			want: Expiration{
+				Status:          ExpirationValid,
+				ReplacementAge:  45,
+				ReplacementDate: time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC),
+				GraceEnd:        time.Date(2025, 4, 11, 0, 0, 0, 0, time.UTC),
+				DaysRemaining:   92,
+				RuleSet:         "828-1997",
+			},
+		},
+		"lifetime after 45": {
+			issueDate: time.Date(2014, 2, 20, 0, 0, 0, 0, time.UTC),
+			birthday:  time.Date(1969, 2, 20, 0, 0, 0, 0, time.UTC),
+			checkDate: time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC),
+			want:      Expiration{Status: ExpirationLifetime, RuleSet: "828-1997"},
+		},
+		"issued before 14": {
+			issueDate: time.Date(2017, 2, 20, 0, 0, 0, 0, time.UTC),
+			birthday:  time.Date(2010, 2, 20, 0, 0, 0, 0, time.UTC),
+			checkDate: time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC),
+			wantErr:   ErrInvalidIssueDateBefore14Birthday,
+		},
+		"issued in future": {
+			issueDate: time.Date(2025, 2, 20, 0, 0, 0, 0, time.UTC),
+			birthday:  time.Date(2000, 2, 20, 0, 0, 0, 0, time.UTC),
+			checkDate: time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC),
+			wantErr:   ErrIssueDatePassportInFuture,
+		},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ExpirationInfo(tt.issueDate, tt.birthday, tt.checkDate)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
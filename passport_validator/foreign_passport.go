@@ -0,0 +1,220 @@
+package passport_validator
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+var (
+	// Проверяем что серия заграничного паспорта состоит из 2 цифр
+	foreignPassportSeriesRegexp = regexp.MustCompile(`^\d{2}$`)
+	// Проверяем что номер заграничного паспорта состоит из 7 цифр
+	foreignPassportNumberRegexp = regexp.MustCompile(`^\d{7}$`)
+	// Имена в заграничном паспорте пишутся латиницей, части имени разделяются пробелом или дефисом
+	latinNameRegexp = regexp.MustCompile(`^[A-Za-z]+([ '-][A-Za-z]+)*$`)
+)
+
+var (
+	ErrInvalidForeignPassportSeries        = errors.New("foreign passport series is not 2 digits")
+	ErrInvalidForeignPassportNumber        = errors.New("foreign passport number is not 7 digits")
+	ErrInvalidForeignPassportType          = errors.New("unknown foreign passport type")
+	ErrNonLatinCharacter                   = errors.New("contains non-Latin symbol")
+	ErrEmptyExpiryDate                     = errors.New("expiry date is zero")
+	ErrForeignPassportExpiryMismatch       = errors.New("expiry date does not match validity of foreign passport type")
+	ErrForeignPassportExpired              = errors.New("foreign passport expired")
+	ErrForeignPassportIssuedBeforeBirthday = fmt.Errorf("foreign passport: %w", ErrIssueDateBeforeBirthday)
+)
+
+// Пути полей заграничного паспорта, которых нет во внутреннем
+const (
+	FieldExpiryDate   = "expiry_date"
+	FieldDocumentType = "document_type"
+)
+
+// ForeignPassportType вид заграничного паспорта, от него зависит срок действия
+type ForeignPassportType int
+
+const (
+	// ForeignPassportOld паспорт старого образца без электронного носителя, выдается на 5 лет
+	ForeignPassportOld ForeignPassportType = iota + 1
+	// ForeignPassportBiometric паспорт с электронным носителем информации, выдается на 10 лет
+	ForeignPassportBiometric
+)
+
+func (t ForeignPassportType) String() string {
+	switch t {
+	case ForeignPassportOld:
+		return "old"
+	case ForeignPassportBiometric:
+		return "biometric"
+	default:
+		return "unknown"
+	}
+}
+
+// ValidityYears срок действия паспорта в годах, 0 для неизвестного вида
+func (t ForeignPassportType) ValidityYears() int {
+	switch t {
+	case ForeignPassportOld:
+		return 5
+	case ForeignPassportBiometric:
+		return 10
+	default:
+		return 0
+	}
+}
+
+// ForeignPassport данные заграничного паспорта гражданина РФ. Кода подразделения и отчества
+// в заграничном паспорте нет, имена записаны латиницей.
+type ForeignPassport struct {
+	LastName   string
+	FirstName  string
+	Series     string
+	Number     string
+	Type       ForeignPassportType
+	IssueDate  time.Time
+	ExpiryDate time.Time
+	Birthday   time.Time
+}
+
+func IsForeignPassportSeriesValid(series string) error {
+	if series == "" {
+		return ErrEmptyPassportSeries
+	}
+	if !foreignPassportSeriesRegexp.MatchString(series) {
+		return ErrInvalidForeignPassportSeries
+	}
+	return nil
+}
+
+func IsForeignPassportNumberValid(number string) error {
+	if number == "" {
+		return ErrEmptyPassportNumber
+	}
+	if !foreignPassportNumberRegexp.MatchString(number) {
+		return ErrInvalidForeignPassportNumber
+	}
+	return nil
+}
+
+func IsForeignPassportLastNameValid(lastName string) error {
+	if lastName == "" {
+		return ErrEmptyLastName
+	}
+	return latinNameValidator(lastName)
+}
+
+func IsForeignPassportFirstNameValid(firstName string) error {
+	if firstName == "" {
+		return ErrEmptyFirstName
+	}
+	return latinNameValidator(firstName)
+}
+
+func latinNameValidator(s string) error {
+	if !latinNameRegexp.MatchString(s) {
+		return ErrNonLatinCharacter
+	}
+	return nil
+}
+
+// ForeignPassportExpiryDate дата окончания срока действия паспорта вида passportType,
+// выданного issueDate владельцу, родившемуся birthday
+func ForeignPassportExpiryDate(passportType ForeignPassportType, issueDate, birthday time.Time) (time.Time, error) {
+	return defaultValidator.ForeignPassportExpiryDate(passportType, issueDate, birthday)
+}
+
+func (v *Validator) ForeignPassportExpiryDate(passportType ForeignPassportType, issueDate, birthday time.Time) (time.Time, error) {
+	years := passportType.ValidityYears()
+	if years == 0 {
+		return time.Time{}, ErrInvalidForeignPassportType
+	}
+	if issueDate.IsZero() {
+		return time.Time{}, ErrEmptyIssueDate
+	}
+	if birthday.IsZero() {
+		return time.Time{}, ErrEmptyBirthday
+	}
+	if issueDate.Before(birthday) {
+		return time.Time{}, ErrForeignPassportIssuedBeforeBirthday
+	}
+
+	if v.foreignChildValidityYears != 0 && AgeAt(birthday, issueDate, v.leapDayPolicy).Years < v.foreignChildAge {
+		years = v.foreignChildValidityYears
+	}
+	return AnniversaryDate(issueDate, years, v.leapDayPolicy), nil
+}
+
+// IsForeignPassportExpiryValid проверяет, что срок действия соответствует виду паспорта и не истек на checkDate.
+// Срок действия заканчивается в день выдачи через 5 или 10 лет, на некоторых бланках днем раньше.
+func IsForeignPassportExpiryValid(passportType ForeignPassportType, issueDate, expiryDate, birthday, checkDate time.Time) error {
+	return defaultValidator.foreignPassportExpiryValid(passportType, issueDate, expiryDate, birthday, checkDate)
+}
+
+// IsForeignPassportExpiryValid проверяет срок действия на текущую дату
+func (v *Validator) IsForeignPassportExpiryValid(passportType ForeignPassportType, issueDate, expiryDate, birthday time.Time) error {
+	return v.foreignPassportExpiryValid(passportType, issueDate, expiryDate, birthday, v.clock.Now())
+}
+
+func (v *Validator) foreignPassportExpiryValid(passportType ForeignPassportType, issueDate, expiryDate, birthday, checkDate time.Time) error {
+	if expiryDate.IsZero() {
+		return ErrEmptyExpiryDate
+	}
+
+	want, err := v.ForeignPassportExpiryDate(passportType, issueDate, birthday)
+	if err != nil {
+		return err
+	}
+	if issueDate.After(checkDate) {
+		return ErrIssueDatePassportInFuture
+	}
+
+	expiryDate = civilDate(expiryDate)
+	if !expiryDate.Equal(want) && !expiryDate.Equal(want.AddDate(0, 0, -1)) {
+		return ErrForeignPassportExpiryMismatch
+	}
+	if civilDate(checkDate).After(expiryDate) {
+		return ErrForeignPassportExpired
+	}
+	return nil
+}
+
+// Validate проверяет все поля заграничного паспорта на дату checkDate и возвращает все найденные ошибки
+func (p ForeignPassport) Validate(checkDate time.Time) error {
+	return p.Check(checkDate).Err()
+}
+
+// Check как Validate, но возвращает ValidationResult
+func (p ForeignPassport) Check(checkDate time.Time) ValidationResult {
+	return defaultValidator.checkForeignPassport(p, checkDate)
+}
+
+// ValidateForeignPassport проверяет заграничный паспорт на текущую дату
+func (v *Validator) ValidateForeignPassport(p ForeignPassport) error {
+	return v.checkForeignPassport(p, v.clock.Now()).Err()
+}
+
+func (v *Validator) checkForeignPassport(p ForeignPassport, checkDate time.Time) ValidationResult {
+	var errs fieldErrors
+
+	errs.check(FieldLastName, p.LastName, IsForeignPassportLastNameValid(p.LastName))
+	errs.check(FieldFirstName, p.FirstName, IsForeignPassportFirstNameValid(p.FirstName))
+	errs.check(FieldSeries, p.Series, IsForeignPassportSeriesValid(p.Series))
+	errs.check(FieldNumber, p.Number, IsForeignPassportNumberValid(p.Number))
+
+	err := v.foreignPassportExpiryValid(p.Type, p.IssueDate, p.ExpiryDate, p.Birthday, checkDate)
+	switch {
+	case errors.Is(err, ErrInvalidForeignPassportType):
+		errs.check(FieldDocumentType, strconv.Itoa(int(p.Type)), err)
+	case errors.Is(err, ErrEmptyBirthday), errors.Is(err, ErrEmptyIssueDate), errors.Is(err, ErrIssueDatePassportInFuture),
+		errors.Is(err, ErrForeignPassportIssuedBeforeBirthday):
+		errs.checkIssueDate(p.IssueDate, err)
+	default:
+		errs.check(FieldExpiryDate, formatDate(p.ExpiryDate), err)
+	}
+
+	return ValidationResult{CheckDate: checkDate, Errors: ValidationErrors(errs)}
+}
@@ -0,0 +1,242 @@
+package passport_validator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validForeignPassport() ForeignPassport {
+	return ForeignPassport{
+		LastName:   "IVANOV",
+		FirstName:  "IVAN",
+		Series:     "75",
+		Number:     "1234567",
+		Type:       ForeignPassportBiometric,
+		IssueDate:  time.Date(2019, 3, 5, 0, 0, 0, 0, time.UTC),
+		ExpiryDate: time.Date(2029, 3, 5, 0, 0, 0, 0, time.UTC),
+		Birthday:   time.Date(1990, 7, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func Test_IsForeignPassportFormatsValid(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validate func() error
+		wantErr  error
+	}{
+		"series":                {validate: func() error { return IsForeignPassportSeriesValid("75") }},
+		"empty series":          {validate: func() error { return IsForeignPassportSeriesValid("") }, wantErr: ErrEmptyPassportSeries},
+		"internal series":       {validate: func() error { return IsForeignPassportSeriesValid("4617") }, wantErr: ErrInvalidForeignPassportSeries},
+		"number":                {validate: func() error { return IsForeignPassportNumberValid("0123456") }},
+		"empty number":          {validate: func() error { return IsForeignPassportNumberValid("") }, wantErr: ErrEmptyPassportNumber},
+		"internal number":       {validate: func() error { return IsForeignPassportNumberValid("123456") }, wantErr: ErrInvalidForeignPassportNumber},
+		"latin name":            {validate: func() error { return IsForeignPassportLastNameValid("IVANOV-PETROV") }},
+		"latin name with space": {validate: func() error { return IsForeignPassportFirstNameValid("Anna Maria") }},
+		"empty first name":      {validate: func() error { return IsForeignPassportFirstNameValid("") }, wantErr: ErrEmptyFirstName},
+		"cyrillic name":         {validate: func() error { return IsForeignPassportLastNameValid("Иванов") }, wantErr: ErrNonLatinCharacter},
+		"trailing hyphen":       {validate: func() error { return IsForeignPassportLastNameValid("IVANOV-") }, wantErr: ErrNonLatinCharacter},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.validate()
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_IsForeignPassportExpiryValid(t *testing.T) {
+	t.Parallel()
+
+	checkDate := time.Date(2024, 2, 27, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		passportType ForeignPassportType
+		issueDate    time.Time
+		expiryDate   time.Time
+		birthday     time.Time
+		wantErr      error
+	}{
+		"biometric 10 years": {
+			passportType: ForeignPassportBiometric,
+			issueDate:    time.Date(2019, 3, 5, 0, 0, 0, 0, time.UTC),
+			expiryDate:   time.Date(2029, 3, 5, 0, 0, 0, 0, time.UTC),
+			birthday:     time.Date(1990, 7, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"old 5 years day before": {
+			passportType: ForeignPassportOld,
+			issueDate:    time.Date(2020, 3, 5, 0, 0, 0, 0, time.UTC),
+			expiryDate:   time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC),
+			birthday:     time.Date(1990, 7, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"old passport with biometric validity": {
+			passportType: ForeignPassportOld,
+			issueDate:    time.Date(2020, 3, 5, 0, 0, 0, 0, time.UTC),
+			expiryDate:   time.Date(2030, 3, 5, 0, 0, 0, 0, time.UTC),
+			birthday:     time.Date(1990, 7, 1, 0, 0, 0, 0, time.UTC),
+			wantErr:      ErrForeignPassportExpiryMismatch,
+		},
+		"expired": {
+			passportType: ForeignPassportOld,
+			issueDate:    time.Date(2018, 3, 5, 0, 0, 0, 0, time.UTC),
+			expiryDate:   time.Date(2023, 3, 5, 0, 0, 0, 0, time.UTC),
+			birthday:     time.Date(1990, 7, 1, 0, 0, 0, 0, time.UTC),
+			wantErr:      ErrForeignPassportExpired,
+		},
+		"last day": {
+			passportType: ForeignPassportOld,
+			issueDate:    time.Date(2019, 2, 27, 0, 0, 0, 0, time.UTC),
+			expiryDate:   time.Date(2024, 2, 27, 0, 0, 0, 0, time.UTC),
+			birthday:     time.Date(1990, 7, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"issued on leap day": {
+			passportType: ForeignPassportOld,
+			issueDate:    time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC),
+			expiryDate:   time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC),
+			birthday:     time.Date(1990, 7, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"child passport": {
+			passportType: ForeignPassportBiometric,
+			issueDate:    time.Date(2020, 3, 5, 0, 0, 0, 0, time.UTC),
+			expiryDate:   time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC),
+			birthday:     time.Date(2020, 1, 10, 0, 0, 0, 0, time.UTC),
+		},
+		"child passport with adult validity": {
+			passportType: ForeignPassportBiometric,
+			issueDate:    time.Date(2020, 3, 5, 0, 0, 0, 0, time.UTC),
+			expiryDate:   time.Date(2030, 3, 5, 0, 0, 0, 0, time.UTC),
+			birthday:     time.Date(2020, 1, 10, 0, 0, 0, 0, time.UTC),
+			wantErr:      ErrForeignPassportExpiryMismatch,
+		},
+		"issued before birthday": {
+			passportType: ForeignPassportBiometric,
+			issueDate:    time.Date(2020, 3, 5, 0, 0, 0, 0, time.UTC),
+			expiryDate:   time.Date(2030, 3, 5, 0, 0, 0, 0, time.UTC),
+			birthday:     time.Date(2020, 4, 10, 0, 0, 0, 0, time.UTC),
+			wantErr:      ErrForeignPassportIssuedBeforeBirthday,
+		},
+		"issued in future": {
+			passportType: ForeignPassportBiometric,
+			issueDate:    time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC),
+			expiryDate:   time.Date(2034, 3, 5, 0, 0, 0, 0, time.UTC),
+			birthday:     time.Date(1990, 7, 1, 0, 0, 0, 0, time.UTC),
+			wantErr:      ErrIssueDatePassportInFuture,
+		},
+		"unknown type": {
+			issueDate:  time.Date(2019, 3, 5, 0, 0, 0, 0, time.UTC),
+			expiryDate: time.Date(2029, 3, 5, 0, 0, 0, 0, time.UTC),
+			birthday:   time.Date(1990, 7, 1, 0, 0, 0, 0, time.UTC),
+			wantErr:    ErrInvalidForeignPassportType,
+		},
+		"empty expiry date": {
+			passportType: ForeignPassportBiometric,
+			issueDate:    time.Date(2019, 3, 5, 0, 0, 0, 0, time.UTC),
+			birthday:     time.Date(1990, 7, 1, 0, 0, 0, 0, time.UTC),
+			wantErr:      ErrEmptyExpiryDate,
+		},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := IsForeignPassportExpiryValid(tt.passportType, tt.issueDate, tt.expiryDate, tt.birthday, checkDate)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_ForeignPassportChildValidity(t *testing.T) {
+	t.Parallel()
+
+	birthday := time.Date(2015, 1, 10, 0, 0, 0, 0, time.UTC)
+	issueDate := time.Date(2020, 3, 5, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		opts     []Option
+		birthday time.Time
+		want     time.Time
+	}{
+		"child by default": {
+			birthday: birthday,
+			want:     time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC),
+		},
+		"adult by default": {
+			birthday: time.Date(2005, 1, 10, 0, 0, 0, 0, time.UTC),
+			want:     time.Date(2030, 3, 5, 0, 0, 0, 0, time.UTC),
+		},
+		"child age overridden": {
+			opts:     []Option{WithForeignPassportChildValidity(18, 5)},
+			birthday: time.Date(2005, 1, 10, 0, 0, 0, 0, time.UTC),
+			want:     time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC),
+		},
+		"child validity disabled": {
+			opts:     []Option{WithForeignPassportChildValidity(0, 0)},
+			birthday: birthday,
+			want:     time.Date(2030, 3, 5, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			expiry, err := NewValidator(tt.opts...).ForeignPassportExpiryDate(ForeignPassportBiometric, issueDate, tt.birthday)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, expiry)
+		})
+	}
+}
+
+func Test_ForeignPassportValidate(t *testing.T) {
+	t.Parallel()
+
+	checkDate := time.Date(2024, 2, 27, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, validForeignPassport().Validate(checkDate))
+
+	p := validForeignPassport()
+	p.FirstName = "Иван"
+	p.Number = "123456"
+	p.ExpiryDate = time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)
+
+	err := p.Validate(checkDate)
+	require.Error(t, err)
+
+	var errs ValidationErrors
+	require.ErrorAs(t, err, &errs)
+	require.Len(t, errs, 3)
+	assert.Equal(t, FieldFirstName, errs[0].Field)
+	assert.ErrorIs(t, errs[0], ErrNonLatinCharacter)
+	assert.Equal(t, FieldNumber, errs[1].Field)
+	assert.ErrorIs(t, errs[1], ErrInvalidForeignPassportNumber)
+	assert.Equal(t, FieldExpiryDate, errs[2].Field)
+	assert.Equal(t, "foreign_expiry_mismatch", errs[2].Code)
+}
+
+func Test_ForeignPassportIssuedBeforeBirthdayCode(t *testing.T) {
+	t.Parallel()
+
+	assert.ErrorIs(t, ErrForeignPassportIssuedBeforeBirthday, ErrIssueDateBeforeBirthday)
+	assert.Equal(t, "issue_date_before_birthday", ErrorCode(ErrForeignPassportIssuedBeforeBirthday))
+}
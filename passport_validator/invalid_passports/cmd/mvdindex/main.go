@@ -0,0 +1,133 @@
+// Команда mvdindex собирает, обновляет и проверяет снимки списка недействительных паспортов МВД.
+//
+//	mvdindex build  -date 2024-02-27 list_of_expired_passports.csv.bz2 snapshot.idx
+//	mvdindex diff   old.idx new.idx changes.diff
+//	mvdindex apply  old.idx changes.diff new.idx
+//	mvdindex verify [-checksum SHA256] snapshot.idx
+//
+// apply принимает изменения только для снимка, из которого они построены, и проверяет, что результат
+// совпадает с новым снимком вплоть до контрольной суммы. verify пересчитывает контрольную сумму снимка
+// и, если передан -checksum, сверяет ее с суммой снимка, записанной вместе с решением.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/zxcSora/passport-validator/passport_validator/invalid_passports"
+)
+
+var errUsage = errors.New("usage: mvdindex build|diff|apply|verify [flags] files...")
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "mvdindex:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	flags := flag.NewFlagSet(args[0], flag.ContinueOnError)
+	date := flags.String("date", "", "дата выгрузки списка в формате 2006-01-02")
+	checksum := flags.String("checksum", "", "ожидаемая контрольная сумма снимка")
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	var sourceDate time.Time
+	if *date != "" {
+		var err error
+		if sourceDate, err = time.Parse("2006-01-02", *date); err != nil {
+			return fmt.Errorf("-date: %w", err)
+		}
+	}
+
+	files := flags.Args()
+	switch {
+	case args[0] == "build" && len(files) == 2:
+		stats, err := invalid_passports.BuildFile(files[0], files[1], sourceDate)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("rows %d, records %d, skipped %d\n", stats.Rows, stats.Records, stats.Skipped)
+		return printMetadata(files[1])
+	case args[0] == "diff" && len(files) == 3:
+		return diff(files[0], files[1], files[2])
+	case args[0] == "apply" && len(files) == 3:
+		stats, err := invalid_passports.ApplyDiffFile(files[0], files[1], files[2])
+		if err != nil {
+			return err
+		}
+		fmt.Printf("added %d, removed %d\n", stats.Added, stats.Removed)
+		return printMetadata(files[2])
+	case args[0] == "verify" && len(files) == 1:
+		return verify(files[0], *checksum)
+	}
+	return errUsage
+}
+
+func diff(fromPath, toPath, diffPath string) error {
+	from, err := invalid_passports.Open(fromPath)
+	if err != nil {
+		return err
+	}
+	defer from.Close()
+
+	to, err := invalid_passports.Open(toPath)
+	if err != nil {
+		return err
+	}
+	defer to.Close()
+
+	out, err := os.Create(diffPath)
+	if err != nil {
+		return err
+	}
+	stats, err := invalid_passports.Diff(from, to, out)
+	if err != nil {
+		out.Close()
+		return err
+	}
+	fmt.Printf("added %d, removed %d\n", stats.Added, stats.Removed)
+	return out.Close()
+}
+
+func verify(path, checksum string) error {
+	idx, err := invalid_passports.Open(path)
+	if err != nil {
+		return err
+	}
+	defer idx.Close()
+
+	if err := idx.Verify(); err != nil {
+		return err
+	}
+	meta := idx.Metadata()
+	if checksum != "" && checksum != meta.Checksum {
+		return fmt.Errorf("%w: want %s, got %s", invalid_passports.ErrChecksumMismatch, checksum, meta.Checksum)
+	}
+	return printMetadata(path)
+}
+
+func printMetadata(path string) error {
+	idx, err := invalid_passports.Open(path)
+	if err != nil {
+		return err
+	}
+	defer idx.Close()
+
+	meta := idx.Metadata()
+	sourceDate := "unknown"
+	if !meta.SourceDate.IsZero() {
+		sourceDate = meta.SourceDate.Format("2006-01-02")
+	}
+	fmt.Printf("%s: source date %s, records %d, sha256 %s\n", path, sourceDate, meta.Records, meta.Checksum)
+	return nil
+}
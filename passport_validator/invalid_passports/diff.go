@@ -0,0 +1,217 @@
+package invalid_passports
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// diffMagic начало заголовка файла изменений:
+//
+//	MVDDIFF01 <SHA-256 исходного снимка> <SHA-256 нового снимка> <дата выгрузки нового снимка или ->
+const diffMagic = "MVDDIFF01"
+
+// DiffStats количество изменений между двумя снимками
+type DiffStats struct {
+	Added   uint64
+	Removed uint64
+}
+
+// Diff пишет в w изменения между снимками from и to: заголовок с контрольными суммами обоих снимков
+// и датой выгрузки to, затем построчно "+4617,123456" для добавленных паспортов и "-4617,123456"
+// для исключенных из списка. Серии и номера идут по возрастанию.
+func Diff(from, to *Index, w io.Writer) (DiffStats, error) {
+	var stats DiffStats
+	bw := bufio.NewWriter(w)
+
+	fromMeta, toMeta := from.Metadata(), to.Metadata()
+	sourceDate := "-"
+	if !toMeta.SourceDate.IsZero() {
+		sourceDate = toMeta.SourceDate.Format("2006-01-02")
+	}
+	if _, err := fmt.Fprintf(bw, "%s %s %s %s\n", diffMagic, fromMeta.Checksum, toMeta.Checksum, sourceDate); err != nil {
+		return stats, err
+	}
+
+	write := func(op byte, series uint16, number uint32) error {
+		_, err := fmt.Fprintf(bw, "%c%04d,%06d\n", op, series, number)
+		return err
+	}
+	writeAll := func(op byte, series uint16, numbers []uint32) error {
+		for _, number := range numbers {
+			if err := write(op, series, number); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	i, j := 0, 0
+	for i < from.containers || j < to.containers {
+		switch {
+		case j == to.containers || i < from.containers && from.entrySeries(i) < to.entrySeries(j):
+			numbers := from.seriesNumbers(i)
+			if err := writeAll('-', from.entrySeries(i), numbers); err != nil {
+				return stats, err
+			}
+			stats.Removed += uint64(len(numbers))
+			i++
+		case i == from.containers || to.entrySeries(j) < from.entrySeries(i):
+			numbers := to.seriesNumbers(j)
+			if err := writeAll('+', to.entrySeries(j), numbers); err != nil {
+				return stats, err
+			}
+			stats.Added += uint64(len(numbers))
+			j++
+		default:
+			series := from.entrySeries(i)
+			old, cur := from.seriesNumbers(i), to.seriesNumbers(j)
+			a, b := 0, 0
+			for a < len(old) || b < len(cur) {
+				var err error
+				switch {
+				case b == len(cur) || a < len(old) && old[a] < cur[b]:
+					err = write('-', series, old[a])
+					stats.Removed++
+					a++
+				case a == len(old) || cur[b] < old[a]:
+					err = write('+', series, cur[b])
+					stats.Added++
+					b++
+				default:
+					a++
+					b++
+				}
+				if err != nil {
+					return stats, err
+				}
+			}
+			i++
+			j++
+		}
+	}
+	return stats, bw.Flush()
+}
+
+// ApplyDiff применяет изменения, записанные Diff, к снимку base и пишет новый индекс в w.
+// Изменения принимаются только для того снимка, из которого они построены: контрольная сумма base
+// сверяется с заголовком. Новый индекс получает дату выгрузки из заголовка, и его контрольная сумма
+// должна совпасть с суммой снимка, до которого построены изменения. Номер, который встречается
+// в изменениях дважды, считается ошибкой: Diff таких строк не пишет.
+func ApplyDiff(base *Index, diff io.Reader, w io.Writer) (DiffStats, error) {
+	var stats DiffStats
+
+	scanner := bufio.NewScanner(diff)
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return stats, err
+		}
+		return stats, fmt.Errorf("diff header: %w", ErrInvalidDiff)
+	}
+	header := strings.Fields(scanner.Text())
+	if len(header) != 4 || header[0] != diffMagic {
+		return stats, fmt.Errorf("diff header: %w", ErrInvalidDiff)
+	}
+	fromChecksum, toChecksum := header[1], header[2]
+	var sourceDate time.Time
+	if header[3] != "-" {
+		var err error
+		if sourceDate, err = time.Parse("2006-01-02", header[3]); err != nil {
+			return stats, fmt.Errorf("diff header: %w", ErrInvalidDiff)
+		}
+	}
+	if checksum := base.Metadata().Checksum; checksum != fromChecksum {
+		return stats, fmt.Errorf("%w: diff from %s, base %s", ErrDiffBaseMismatch, fromChecksum, checksum)
+	}
+
+	var containers [maxSeries]*container
+	for i := 0; i < base.containers; i++ {
+		containers[base.entrySeries(i)] = base.seriesContainer(i)
+	}
+
+	// seen номера, уже встреченные в изменениях: серия*numbersPerSeries+номер
+	seen := make(map[uint64]bool)
+	line := 1
+	for scanner.Scan() {
+		line++
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		series, number, ok := parseLine(text[1:])
+		if !ok || text[0] != '+' && text[0] != '-' {
+			return stats, fmt.Errorf("diff line %d: %w", line, ErrInvalidDiff)
+		}
+		// Ключ в uint64: серия*numbersPerSeries переполняет uint32 для серий от 4295
+		key := uint64(series)*numbersPerSeries + uint64(number)
+		if seen[key] {
+			return stats, fmt.Errorf("diff line %d: %w", line, ErrDiffConflict)
+		}
+		seen[key] = true
+
+		if containers[series] == nil {
+			containers[series] = &container{}
+		}
+		if text[0] == '+' {
+			containers[series].add(number)
+			stats.Added++
+		} else {
+			containers[series].remove(number)
+			stats.Removed++
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return stats, err
+	}
+
+	meta, err := writeIndex(w, containers[:], sourceDate)
+	if err != nil {
+		return stats, err
+	}
+	if meta.Checksum != toChecksum {
+		return stats, fmt.Errorf("applied diff: %w: want %s, got %s", ErrChecksumMismatch, toChecksum, meta.Checksum)
+	}
+	return stats, nil
+}
+
+// ApplyDiffFile применяет файл изменений diffPath к индексу basePath и атомарно записывает
+// новый индекс в dst. Контрольная сумма basePath пересчитывается перед применением.
+func ApplyDiffFile(basePath, diffPath, dst string) (DiffStats, error) {
+	base, err := Open(basePath)
+	if err != nil {
+		return DiffStats{}, err
+	}
+	defer base.Close()
+
+	if err := base.Verify(); err != nil {
+		return DiffStats{}, fmt.Errorf("%s: %w", basePath, err)
+	}
+
+	diff, err := os.Open(diffPath)
+	if err != nil {
+		return DiffStats{}, err
+	}
+	defer diff.Close()
+
+	out, err := os.CreateTemp(filepath.Dir(dst), filepath.Base(dst)+".*.tmp")
+	if err != nil {
+		return DiffStats{}, err
+	}
+	defer os.Remove(out.Name())
+
+	stats, err := ApplyDiff(base, diff, out)
+	if err != nil {
+		out.Close()
+		return stats, err
+	}
+	if err := out.Close(); err != nil {
+		return stats, err
+	}
+	return stats, os.Rename(out.Name(), dst)
+}
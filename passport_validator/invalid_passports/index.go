@@ -0,0 +1,591 @@
+// Package invalid_passports проверка паспортов по списку недействительных паспортов МВД.
+//
+// Список (около 150 млн пар серия/номер) импортируется из опубликованного CSV файла в компактный
+// индекс на диске. Для каждой серии хранится либо отсортированный массив номеров, либо битовая
+// карта на миллион номеров, если так компактнее. Индекс отображается в память и не требует
+// разбора при открытии.
+//
+// В конце файла индекса хранятся метаданные снимка: дата выгрузки списка и SHA-256 всего
+// содержимого до контрольной суммы, включая дату выгрузки. Контрольная сумма однозначно определяет
+// снимок, по которому принято решение, и проверяется командой verify из cmd/mvdindex.
+package invalid_passports
+
+import (
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/zxcSora/passport-validator/passport_validator"
+)
+
+const (
+	magic      = "MVDIDX01"
+	headerSize = 32
+	entrySize  = 16
+
+	trailerMagic = "MVDEND01"
+	// trailerSize дата выгрузки, SHA-256 и trailerMagic
+	trailerSize = 8 + sha256.Size + len(trailerMagic)
+
+	maxSeries        = 10000
+	numbersPerSeries = 1000000
+	bitmapSize       = numbersPerSeries / 8
+	// arrayMaxCardinality при большем количестве номеров в серии битовая карта компактнее массива
+	arrayMaxCardinality = bitmapSize / 4
+)
+
+const (
+	containerArray  byte = 1
+	containerBitmap byte = 2
+)
+
+var (
+	ErrInvalidIndex     = errors.New("invalid passports index is corrupted")
+	ErrChecksumMismatch = errors.New("invalid passports index checksum mismatch")
+	ErrInvalidDiff      = errors.New("invalid passports diff line must be +SERIES,NUMBER or -SERIES,NUMBER")
+	ErrDiffBaseMismatch = errors.New("invalid passports diff was built from another snapshot")
+	ErrDiffConflict     = errors.New("invalid passports diff changes the same passport twice")
+)
+
+// Metadata метаданные снимка списка
+type Metadata struct {
+	// SourceDate дата выгрузки списка МВД, из которой построен снимок
+	SourceDate time.Time
+	// Records количество пар серия/номер
+	Records uint64
+	// Checksum SHA-256 содержимого индекса в hex
+	Checksum string
+}
+
+// BuildStats итоги импорта списка
+type BuildStats struct {
+	// Rows количество прочитанных строк без заголовка
+	Rows uint64
+	// Records количество уникальных пар серия/номер в индексе
+	Records uint64
+	// Skipped количество строк, которые не удалось разобрать (в списке МВД встречаются
+	// серии паспортов старого образца и опечатки)
+	Skipped uint64
+}
+
+// Build импортирует список в формате CSV "PASSP_SERIES,PASSP_NUMBER" из r и пишет индекс в w.
+// Сжатый bzip2 список распознается автоматически. sourceDate дата выгрузки списка.
+func Build(r io.Reader, w io.Writer, sourceDate time.Time) (BuildStats, error) {
+	var stats BuildStats
+
+	br := bufio.NewReaderSize(r, 1<<20)
+	if header, err := br.Peek(3); err == nil && string(header) == "BZh" {
+		br = bufio.NewReaderSize(bzip2.NewReader(br), 1<<20)
+	}
+
+	var containers [maxSeries]*container
+	scanner := bufio.NewScanner(br)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		series, number, ok := parseLine(line)
+		if !ok {
+			// Заголовок файла не считаем ошибкой разбора
+			if stats.Rows == 0 && stats.Skipped == 0 && bytes.HasPrefix(line, []byte("PASSP_SERIES")) {
+				continue
+			}
+			stats.Rows++
+			stats.Skipped++
+			continue
+		}
+		stats.Rows++
+
+		if containers[series] == nil {
+			containers[series] = &container{}
+		}
+		containers[series].add(number)
+	}
+	if err := scanner.Err(); err != nil {
+		return stats, err
+	}
+
+	meta, err := writeIndex(w, containers[:], sourceDate)
+	stats.Records = meta.Records
+	return stats, err
+}
+
+// BuildFile импортирует список из файла src в индекс dst. Индекс сначала пишется во временный
+// файл рядом с dst и переименовывается, поэтому открытый индекс dst никогда не бывает недописанным.
+func BuildFile(src, dst string, sourceDate time.Time) (BuildStats, error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return BuildStats{}, err
+	}
+	defer in.Close()
+
+	out, err := os.CreateTemp(filepath.Dir(dst), filepath.Base(dst)+".*.tmp")
+	if err != nil {
+		return BuildStats{}, err
+	}
+	defer os.Remove(out.Name())
+
+	stats, err := Build(in, out, sourceDate)
+	if err != nil {
+		out.Close()
+		return stats, err
+	}
+	if err := out.Close(); err != nil {
+		return stats, err
+	}
+	return stats, os.Rename(out.Name(), dst)
+}
+
+// parseLine разбирает строку "4617,123456". Серии старого образца и номера не из 6 цифр пропускаются.
+func parseLine(line []byte) (series uint16, number uint32, ok bool) {
+	comma := bytes.IndexByte(line, ',')
+	if comma < 0 {
+		return 0, 0, false
+	}
+
+	s, ok := parseDigits(bytes.TrimSpace(line[:comma]), 4)
+	if !ok {
+		return 0, 0, false
+	}
+	n, ok := parseDigits(bytes.TrimSpace(line[comma+1:]), 6)
+	if !ok {
+		return 0, 0, false
+	}
+	return uint16(s), n, true
+}
+
+func parseDigits(b []byte, length int) (uint32, bool) {
+	if len(b) != length {
+		return 0, false
+	}
+	var value uint32
+	for _, c := range b {
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		value = value*10 + uint32(c-'0')
+	}
+	return value, true
+}
+
+// container номера одной серии. Пока номеров мало, они копятся в массиве, потом переносятся в битовую карту.
+type container struct {
+	numbers []uint32
+	bitmap  []byte
+	// removed номера, удаляемые обновлением, применяются в finalize после всех добавлений
+	removed []uint32
+}
+
+func (c *container) add(number uint32) {
+	if c.bitmap != nil {
+		c.bitmap[number>>3] |= 1 << (number & 7)
+		return
+	}
+
+	c.numbers = append(c.numbers, number)
+	// Дубликаты уберутся при записи, поэтому переходим на карту с запасом
+	if len(c.numbers) > 2*arrayMaxCardinality {
+		c.toBitmap()
+	}
+}
+
+func (c *container) toBitmap() {
+	c.bitmap = make([]byte, bitmapSize)
+	for _, number := range c.numbers {
+		c.bitmap[number>>3] |= 1 << (number & 7)
+	}
+	c.numbers = nil
+}
+
+func (c *container) remove(number uint32) {
+	c.removed = append(c.removed, number)
+}
+
+// finalize выбирает компактное представление и возвращает его вид и количество номеров
+func (c *container) finalize() (kind byte, cardinality uint32) {
+	if c.bitmap == nil {
+		c.numbers = sortUnique(c.numbers)
+		if len(c.removed) != 0 {
+			c.numbers = subtract(c.numbers, sortUnique(c.removed))
+		}
+		c.removed = nil
+		if len(c.numbers) <= arrayMaxCardinality {
+			return containerArray, uint32(len(c.numbers))
+		}
+		c.toBitmap()
+	}
+
+	for _, number := range c.removed {
+		c.bitmap[number>>3] &^= 1 << (number & 7)
+	}
+	c.removed = nil
+	for _, b := range c.bitmap {
+		cardinality += uint32(bits.OnesCount8(b))
+	}
+	if cardinality <= arrayMaxCardinality {
+		c.numbers = bitmapNumbers(c.bitmap, make([]uint32, 0, cardinality))
+		c.bitmap = nil
+		return containerArray, cardinality
+	}
+	return containerBitmap, cardinality
+}
+
+func sortUnique(numbers []uint32) []uint32 {
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+	unique := numbers[:0]
+	for i, number := range numbers {
+		if i == 0 || number != numbers[i-1] {
+			unique = append(unique, number)
+		}
+	}
+	return unique
+}
+
+// subtract убирает из отсортированного numbers номера отсортированного removed
+func subtract(numbers, removed []uint32) []uint32 {
+	result := numbers[:0]
+	j := 0
+	for _, number := range numbers {
+		for j < len(removed) && removed[j] < number {
+			j++
+		}
+		if j < len(removed) && removed[j] == number {
+			continue
+		}
+		result = append(result, number)
+	}
+	return result
+}
+
+// bitmapNumbers дописывает в dst номера битовой карты по возрастанию
+func bitmapNumbers(bitmap []byte, dst []uint32) []uint32 {
+	for i, b := range bitmap {
+		for b != 0 {
+			bit := bits.TrailingZeros8(b)
+			dst = append(dst, uint32(i)<<3|uint32(bit))
+			b &= b - 1
+		}
+	}
+	return dst
+}
+
+// writeIndex пишет заголовок, каталог серий, данные серий и метаданные снимка и возвращает эти метаданные
+func writeIndex(w io.Writer, containers []*container, sourceDate time.Time) (Metadata, error) {
+	type entry struct {
+		series      uint16
+		kind        byte
+		cardinality uint32
+		offset      uint64
+	}
+
+	var entries []entry
+	var records uint64
+	for series, c := range containers {
+		if c == nil {
+			continue
+		}
+		kind, cardinality := c.finalize()
+		if cardinality == 0 {
+			continue
+		}
+		entries = append(entries, entry{series: uint16(series), kind: kind, cardinality: cardinality})
+		records += uint64(cardinality)
+	}
+
+	offset := uint64(headerSize + len(entries)*entrySize)
+	for i := range entries {
+		entries[i].offset = offset
+		if entries[i].kind == containerArray {
+			offset += uint64(entries[i].cardinality) * 4
+		} else {
+			offset += bitmapSize
+		}
+	}
+
+	bw := bufio.NewWriterSize(w, 1<<20)
+	hash := sha256.New()
+	hw := io.MultiWriter(bw, hash)
+
+	header := make([]byte, headerSize)
+	copy(header, magic)
+	binary.LittleEndian.PutUint64(header[8:], records)
+	binary.LittleEndian.PutUint32(header[16:], uint32(len(entries)))
+	if _, err := hw.Write(header); err != nil {
+		return Metadata{}, err
+	}
+
+	buf := make([]byte, entrySize)
+	for _, e := range entries {
+		binary.LittleEndian.PutUint16(buf[0:], e.series)
+		buf[2] = e.kind
+		buf[3] = 0
+		binary.LittleEndian.PutUint32(buf[4:], e.cardinality)
+		binary.LittleEndian.PutUint64(buf[8:], e.offset)
+		if _, err := hw.Write(buf); err != nil {
+			return Metadata{}, err
+		}
+	}
+
+	for _, e := range entries {
+		c := containers[e.series]
+		if e.kind == containerBitmap {
+			if _, err := hw.Write(c.bitmap); err != nil {
+				return Metadata{}, err
+			}
+			continue
+		}
+		for _, number := range c.numbers {
+			binary.LittleEndian.PutUint32(buf[0:], number)
+			if _, err := hw.Write(buf[:4]); err != nil {
+				return Metadata{}, err
+			}
+		}
+	}
+
+	// Дата выгрузки входит в контрольную сумму, иначе ее можно подменить без ошибки Verify
+	date := make([]byte, 8)
+	if !sourceDate.IsZero() {
+		binary.LittleEndian.PutUint64(date, uint64(sourceDate.Unix()))
+	}
+	if _, err := hw.Write(date); err != nil {
+		return Metadata{}, err
+	}
+	sum := hash.Sum(nil)
+	if _, err := bw.Write(append(sum, trailerMagic...)); err != nil {
+		return Metadata{}, err
+	}
+
+	meta := Metadata{Records: records, Checksum: hex.EncodeToString(sum)}
+	if !sourceDate.IsZero() {
+		meta.SourceDate = time.Unix(sourceDate.Unix(), 0).UTC()
+	}
+	return meta, bw.Flush()
+}
+
+// Index индекс списка недействительных паспортов. Безопасен для конкурентного чтения.
+type Index struct {
+	data       []byte
+	records    uint64
+	containers int
+	unmap      func([]byte) error
+}
+
+// Open открывает индекс, построенный Build, отображая файл в память
+func Open(path string) (*Index, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() < int64(headerSize+trailerSize) {
+		return nil, ErrInvalidIndex
+	}
+
+	data, unmap, err := mapFile(f, int(info.Size()))
+	if err != nil {
+		return nil, fmt.Errorf("map %s: %w", path, err)
+	}
+
+	idx, err := NewIndex(data)
+	if err != nil {
+		_ = unmap(data)
+		return nil, err
+	}
+	idx.unmap = unmap
+	return idx, nil
+}
+
+// NewIndex создает индекс поверх данных, записанных Build
+func NewIndex(data []byte) (*Index, error) {
+	if len(data) < headerSize+trailerSize || string(data[:len(magic)]) != magic ||
+		string(data[len(data)-len(trailerMagic):]) != trailerMagic {
+		return nil, ErrInvalidIndex
+	}
+	body := uint64(len(data) - trailerSize)
+
+	idx := &Index{
+		data:       data,
+		records:    binary.LittleEndian.Uint64(data[8:]),
+		containers: int(binary.LittleEndian.Uint32(data[16:])),
+	}
+	if idx.containers > maxSeries {
+		return nil, ErrInvalidIndex
+	}
+	// Данные серий лежат после каталога, поэтому смещения в заголовок и каталог тоже ошибка
+	dataStart := uint64(headerSize + idx.containers*entrySize)
+	if body < dataStart {
+		return nil, ErrInvalidIndex
+	}
+	for i := 0; i < idx.containers; i++ {
+		// Поиск по каталогу двоичный, серии должны идти строго по возрастанию
+		series := idx.entrySeries(i)
+		if series >= maxSeries || i > 0 && series <= idx.entrySeries(i-1) {
+			return nil, ErrInvalidIndex
+		}
+
+		kind, cardinality, offset := idx.entry(i)
+		var size uint64
+		switch kind {
+		case containerArray:
+			if cardinality > arrayMaxCardinality {
+				return nil, ErrInvalidIndex
+			}
+			size = uint64(cardinality) * 4
+		case containerBitmap:
+			size = bitmapSize
+		default:
+			return nil, ErrInvalidIndex
+		}
+		// offset+size может переполниться, поэтому сравниваем с остатком
+		if offset < dataStart || offset > body || size > body-offset {
+			return nil, ErrInvalidIndex
+		}
+	}
+	return idx, nil
+}
+
+// Close освобождает отображение файла в память. После Close индексом пользоваться нельзя,
+// в том числе в уже запущенных проверках.
+func (idx *Index) Close() error {
+	if idx.unmap == nil {
+		return nil
+	}
+	unmap := idx.unmap
+	idx.unmap = nil
+	return unmap(idx.data)
+}
+
+// Len количество пар серия/номер в индексе
+func (idx *Index) Len() uint64 {
+	return idx.records
+}
+
+// Metadata метаданные снимка из индекса
+func (idx *Index) Metadata() Metadata {
+	trailer := idx.data[len(idx.data)-trailerSize:]
+
+	var sourceDate time.Time
+	if unix := int64(binary.LittleEndian.Uint64(trailer)); unix != 0 {
+		sourceDate = time.Unix(unix, 0).UTC()
+	}
+	return Metadata{
+		SourceDate: sourceDate,
+		Records:    idx.records,
+		Checksum:   hex.EncodeToString(trailer[8 : 8+sha256.Size]),
+	}
+}
+
+// Verify пересчитывает контрольную сумму индекса и сверяет ее с записанной в метаданных.
+// Проверка читает весь файл, поэтому ее не нужно выполнять на каждый запрос.
+func (idx *Index) Verify() error {
+	sum := sha256.Sum256(idx.data[:len(idx.data)-trailerSize+8])
+	if hex.EncodeToString(sum[:]) != idx.Metadata().Checksum {
+		return ErrChecksumMismatch
+	}
+	return nil
+}
+
+// IsListedInvalid true, если паспорт есть в списке недействительных
+func (idx *Index) IsListedInvalid(series, number string) (bool, error) {
+	s, n, err := parseKey(series, number)
+	if err != nil {
+		return false, err
+	}
+	return idx.contains(s, n), nil
+}
+
+// Check возвращает passport_validator.ErrPassportListedInvalid, если паспорт есть в списке недействительных
+func (idx *Index) Check(series, number string) error {
+	listed, err := idx.IsListedInvalid(series, number)
+	if err != nil {
+		return err
+	}
+	if listed {
+		return passport_validator.ErrPassportListedInvalid
+	}
+	return nil
+}
+
+// parseKey проверяет серию и номер теми же правилами формата, что и passport_validator
+func parseKey(series, number string) (uint16, uint32, error) {
+	if series == "" {
+		return 0, 0, passport_validator.ErrEmptyPassportSeries
+	}
+	s, ok := parseDigits([]byte(series), 4)
+	if !ok {
+		return 0, 0, passport_validator.ErrInvalidPassportSeriesNot4Digits
+	}
+	if err := passport_validator.IsPassportNumberValid(number); err != nil {
+		return 0, 0, err
+	}
+	n, _ := parseDigits([]byte(number), 6)
+	return uint16(s), n, nil
+}
+
+func (idx *Index) entry(i int) (kind byte, cardinality uint32, offset uint64) {
+	e := idx.data[headerSize+i*entrySize:]
+	return e[2], binary.LittleEndian.Uint32(e[4:]), binary.LittleEndian.Uint64(e[8:])
+}
+
+func (idx *Index) entrySeries(i int) uint16 {
+	return binary.LittleEndian.Uint16(idx.data[headerSize+i*entrySize:])
+}
+
+func (idx *Index) contains(series uint16, number uint32) bool {
+	i := sort.Search(idx.containers, func(i int) bool { return idx.entrySeries(i) >= series })
+	if i == idx.containers || idx.entrySeries(i) != series {
+		return false
+	}
+
+	kind, cardinality, offset := idx.entry(i)
+	if kind == containerBitmap {
+		return idx.data[offset+uint64(number>>3)]&(1<<(number&7)) != 0
+	}
+
+	numbers := idx.data[offset : offset+uint64(cardinality)*4]
+	j := sort.Search(int(cardinality), func(j int) bool {
+		return binary.LittleEndian.Uint32(numbers[j*4:]) >= number
+	})
+	return j < int(cardinality) && binary.LittleEndian.Uint32(numbers[j*4:]) == number
+}
+
+// seriesNumbers номера i-й серии каталога по возрастанию
+func (idx *Index) seriesNumbers(i int) []uint32 {
+	kind, cardinality, offset := idx.entry(i)
+	if kind == containerBitmap {
+		return bitmapNumbers(idx.data[offset:offset+bitmapSize], make([]uint32, 0, cardinality))
+	}
+
+	numbers := make([]uint32, cardinality)
+	for j := range numbers {
+		numbers[j] = binary.LittleEndian.Uint32(idx.data[offset+uint64(j)*4:])
+	}
+	return numbers
+}
+
+// seriesContainer копия i-й серии каталога для изменения
+func (idx *Index) seriesContainer(i int) *container {
+	kind, _, offset := idx.entry(i)
+	if kind == containerBitmap {
+		return &container{bitmap: append([]byte(nil), idx.data[offset:offset+bitmapSize]...)}
+	}
+	return &container{numbers: idx.seriesNumbers(i)}
+}
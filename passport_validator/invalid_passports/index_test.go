@@ -0,0 +1,160 @@
+package invalid_passports
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zxcSora/passport-validator/passport_validator"
+)
+
+func Test_BuildFileFromBzip2(t *testing.T) {
+	t.Parallel()
+
+	dst := filepath.Join(t.TempDir(), "invalid.idx")
+	stats, err := BuildFile(filepath.Join("testdata", "list_of_expired_passports.csv.bz2"), dst, time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, BuildStats{Rows: 6, Records: 4, Skipped: 2}, stats)
+
+	idx, err := Open(dst)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = idx.Close() })
+
+	assert.Equal(t, uint64(4), idx.Len())
+
+	testCases := map[string]struct {
+		series  string
+		number  string
+		want    bool
+		wantErr error
+	}{
+		"listed":              {series: "4617", number: "123456", want: true},
+		"listed leading zero": {series: "0101", number: "000000", want: true},
+		"same series":         {series: "4617", number: "123457"},
+		"unknown series":      {series: "4618", number: "123456"},
+		"empty series":        {series: "", number: "123456", wantErr: passport_validator.ErrEmptyPassportSeries},
+		"invalid series":      {series: "46-17", number: "123456", wantErr: passport_validator.ErrInvalidPassportSeriesNot4Digits},
+		"invalid number":      {series: "4617", number: "12345", wantErr: passport_validator.ErrInvalidPassportNumber},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			listed, err := idx.IsListedInvalid(tt.series, tt.number)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, listed)
+		})
+	}
+}
+
+func Test_IndexBitmapContainer(t *testing.T) {
+	t.Parallel()
+
+	var csv strings.Builder
+	csv.WriteString("PASSP_SERIES,PASSP_NUMBER\n")
+	// Каждый третий номер серии 4505 — больше порога массива, серия хранится битовой картой
+	for n := 0; n < numbersPerSeries; n += 3 {
+		fmt.Fprintf(&csv, "4505,%06d\n", n)
+	}
+	csv.WriteString("4505,000000\n")
+	csv.WriteString("4617,123456\n")
+
+	var buf bytes.Buffer
+	stats, err := Build(strings.NewReader(csv.String()), &buf, time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(numbersPerSeries/3+2), stats.Records)
+
+	idx, err := NewIndex(buf.Bytes())
+	require.NoError(t, err)
+
+	kind, _, _ := idx.entry(0)
+	assert.Equal(t, containerBitmap, kind)
+
+	assert.NoError(t, idx.Check("4505", "000001"))
+	assert.ErrorIs(t, idx.Check("4505", "999999"), passport_validator.ErrPassportListedInvalid)
+	assert.ErrorIs(t, idx.Check("4617", "123456"), passport_validator.ErrPassportListedInvalid)
+	assert.NoError(t, idx.Check("4617", "123455"))
+}
+
+func Test_OpenCorruptedIndex(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "broken.idx")
+	require.NoError(t, os.WriteFile(path, []byte("PASSP_SERIES,PASSP_NUMBER\n4617,123456\n"), 0o600))
+
+	_, err := Open(path)
+	assert.ErrorIs(t, err, ErrInvalidIndex)
+}
+
+func Test_NewIndexCorruptedDirectory(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	_, err := Build(strings.NewReader("PASSP_SERIES,PASSP_NUMBER\n4505,000001\n4617,123456\n"), &buf, time.Time{})
+	require.NoError(t, err)
+	_, err = NewIndex(buf.Bytes())
+	require.NoError(t, err)
+
+	entry := func(i int) int { return headerSize + i*entrySize }
+
+	testCases := map[string]func(data []byte){
+		"offset overflow": func(data []byte) {
+			binary.LittleEndian.PutUint64(data[entry(0)+8:], ^uint64(0)-2)
+		},
+		"offset past body": func(data []byte) {
+			binary.LittleEndian.PutUint64(data[entry(1)+8:], uint64(len(data)))
+		},
+		"offset into directory": func(data []byte) {
+			binary.LittleEndian.PutUint64(data[entry(0)+8:], uint64(headerSize))
+		},
+		"cardinality overflow": func(data []byte) {
+			binary.LittleEndian.PutUint32(data[entry(1)+4:], ^uint32(0))
+		},
+		"unknown container kind": func(data []byte) {
+			data[entry(0)+2] = 7
+		},
+		"unsorted series": func(data []byte) {
+			binary.LittleEndian.PutUint16(data[entry(0):], 4618)
+		},
+		"duplicate series": func(data []byte) {
+			binary.LittleEndian.PutUint16(data[entry(0):], 4617)
+		},
+		"series out of range": func(data []byte) {
+			binary.LittleEndian.PutUint16(data[entry(1):], maxSeries)
+		},
+		"too many containers": func(data []byte) {
+			binary.LittleEndian.PutUint32(data[16:], maxSeries+1)
+		},
+	}
+
+	for name, corrupt := range testCases {
+		corrupt := corrupt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := append([]byte(nil), buf.Bytes()...)
+			corrupt(data)
+
+			require.NotPanics(t, func() {
+				_, err := NewIndex(data)
+				assert.ErrorIs(t, err, ErrInvalidIndex)
+			})
+		})
+	}
+}
@@ -0,0 +1,17 @@
+//go:build !unix
+
+package invalid_passports
+
+import (
+	"io"
+	"os"
+)
+
+// mapFile на платформах без mmap читает файл в память целиком
+func mapFile(f *os.File, size int) ([]byte, func([]byte) error, error) {
+	data := make([]byte, size)
+	if _, err := io.ReadFull(f, data); err != nil {
+		return nil, nil, err
+	}
+	return data, func([]byte) error { return nil }, nil
+}
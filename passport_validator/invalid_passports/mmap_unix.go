@@ -0,0 +1,17 @@
+//go:build unix
+
+package invalid_passports
+
+import (
+	"os"
+	"syscall"
+)
+
+// mapFile отображает файл в память только для чтения
+func mapFile(f *os.File, size int) ([]byte, func([]byte) error, error) {
+	data, err := syscall.Mmap(int(f.Fd()), 0, size, syscall.PROT_READ, syscall.MAP_SHARED)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, syscall.Munmap, nil
+}
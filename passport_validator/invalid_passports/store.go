@@ -0,0 +1,122 @@
+package invalid_passports
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/zxcSora/passport-validator/passport_validator"
+)
+
+var ErrStoreClosed = errors.New("invalid passports store is closed")
+
+// Store текущий снимок списка недействительных паспортов с атомарной заменой. Проверки
+// выполняются конкурентно с Swap: каждая проверка целиком проходит по одному снимку, а старый
+// снимок закрывается только после завершения начатых на нем проверок.
+type Store struct {
+	mu    sync.RWMutex
+	index *Index
+}
+
+// NewStore создает хранилище с начальным снимком idx, который переходит во владение хранилища
+func NewStore(idx *Index) *Store {
+	return &Store{index: idx}
+}
+
+// OpenStore открывает индекс path, проверяет его контрольную сумму и создает хранилище
+func OpenStore(path string) (*Store, error) {
+	idx, err := openVerified(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewStore(idx), nil
+}
+
+// Load открывает и проверяет снимок path и атомарно подменяет им текущий.
+// Если снимок поврежден, текущий остается в работе.
+func (s *Store) Load(path string) (Metadata, error) {
+	idx, err := openVerified(path)
+	if err != nil {
+		return Metadata{}, err
+	}
+	if err := s.Swap(idx); err != nil {
+		_ = idx.Close()
+		return Metadata{}, err
+	}
+	return idx.Metadata(), nil
+}
+
+// Swap подменяет текущий снимок на idx и закрывает предыдущий
+func (s *Store) Swap(idx *Index) error {
+	s.mu.Lock()
+	old := s.index
+	if old == nil {
+		s.mu.Unlock()
+		return ErrStoreClosed
+	}
+	s.index = idx
+	s.mu.Unlock()
+
+	return old.Close()
+}
+
+// Metadata метаданные текущего снимка
+func (s *Store) Metadata() (Metadata, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.index == nil {
+		return Metadata{}, ErrStoreClosed
+	}
+	return s.index.Metadata(), nil
+}
+
+// IsListedInvalid true, если паспорт есть в списке недействительных. Вместе с ответом
+// возвращаются метаданные снимка, по которому он получен.
+func (s *Store) IsListedInvalid(series, number string) (bool, Metadata, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.index == nil {
+		return false, Metadata{}, ErrStoreClosed
+	}
+	listed, err := s.index.IsListedInvalid(series, number)
+	return listed, s.index.Metadata(), err
+}
+
+// Check возвращает passport_validator.ErrPassportListedInvalid, если паспорт есть в списке
+// недействительных, и метаданные снимка, по которому принято решение
+func (s *Store) Check(series, number string) (Metadata, error) {
+	listed, meta, err := s.IsListedInvalid(series, number)
+	if err != nil {
+		return meta, err
+	}
+	if listed {
+		return meta, passport_validator.ErrPassportListedInvalid
+	}
+	return meta, nil
+}
+
+// Close закрывает текущий снимок, дожидаясь завершения начатых проверок
+func (s *Store) Close() error {
+	s.mu.Lock()
+	idx := s.index
+	s.index = nil
+	s.mu.Unlock()
+
+	if idx == nil {
+		return nil
+	}
+	return idx.Close()
+}
+
+func openVerified(path string) (*Index, error) {
+	idx, err := Open(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := idx.Verify(); err != nil {
+		_ = idx.Close()
+		return nil, err
+	}
+	return idx, nil
+}
@@ -0,0 +1,278 @@
+package invalid_passports
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zxcSora/passport-validator/passport_validator"
+)
+
+func buildIndex(t *testing.T, csv string, sourceDate time.Time) *Index {
+	t.Helper()
+
+	var buf bytes.Buffer
+	_, err := Build(strings.NewReader(csv), &buf, sourceDate)
+	require.NoError(t, err)
+
+	idx, err := NewIndex(buf.Bytes())
+	require.NoError(t, err)
+	return idx
+}
+
+func Test_DiffApplyDiff(t *testing.T) {
+	t.Parallel()
+
+	sourceDate := time.Date(2024, 2, 28, 0, 0, 0, 0, time.UTC)
+	from := buildIndex(t, "4617,123456\n4617,000001\n4505,999999\n0101,000000\n", time.Time{})
+	to := buildIndex(t, "4617,123456\n4617,000002\n4505,999999\n4620,111111\n", sourceDate)
+
+	var diff bytes.Buffer
+	stats, err := Diff(from, to, &diff)
+	require.NoError(t, err)
+	assert.Equal(t, DiffStats{Added: 2, Removed: 2}, stats)
+	header := diffMagic + " " + from.Metadata().Checksum + " " + to.Metadata().Checksum + " 2024-02-28\n"
+	assert.Equal(t, header+"-0101,000000\n-4617,000001\n+4617,000002\n+4620,111111\n", diff.String())
+
+	var applied bytes.Buffer
+	_, err = ApplyDiff(from, &diff, &applied)
+	require.NoError(t, err)
+
+	idx, err := NewIndex(applied.Bytes())
+	require.NoError(t, err)
+	require.NoError(t, idx.Verify())
+	assert.Equal(t, to.Metadata(), idx.Metadata())
+}
+
+func Test_ApplyDiffErrors(t *testing.T) {
+	t.Parallel()
+
+	from := buildIndex(t, "4617,123456\n", time.Time{})
+	to := buildIndex(t, "4617,123456\n4617,000001\n", time.Time{})
+	other := buildIndex(t, "4505,999999\n", time.Time{})
+	header := diffMagic + " " + from.Metadata().Checksum + " " + to.Metadata().Checksum + " -\n"
+
+	testCases := map[string]struct {
+		base    *Index
+		diff    string
+		wantErr error
+	}{
+		"another base": {
+			base:    other,
+			diff:    header + "+4617,000001\n",
+			wantErr: ErrDiffBaseMismatch,
+		},
+		"without header": {
+			base:    from,
+			diff:    "+4617,000001\n",
+			wantErr: ErrInvalidDiff,
+		},
+		"empty": {
+			base:    from,
+			wantErr: ErrInvalidDiff,
+		},
+		"invalid line": {
+			base:    from,
+			diff:    header + "+4617,000001\n4617,000002\n",
+			wantErr: ErrInvalidDiff,
+		},
+		"removed and added": {
+			base:    from,
+			diff:    header + "-4617,000001\n+4617,000001\n",
+			wantErr: ErrDiffConflict,
+		},
+		"added twice": {
+			base:    from,
+			diff:    header + "+4617,000001\n+4617,000001\n",
+			wantErr: ErrDiffConflict,
+		},
+		"result differs from target": {
+			base:    from,
+			diff:    header + "+4617,000002\n",
+			wantErr: ErrChecksumMismatch,
+		},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ApplyDiff(tt.base, strings.NewReader(tt.diff), &bytes.Buffer{})
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_ApplyDiffDistinctKeys(t *testing.T) {
+	t.Parallel()
+
+	// В uint32 ключи 0322,156160 и 4617,123456 совпадали: 4617123456 mod 2^32 = 322156160
+	from := buildIndex(t, "4505,999999\n", time.Time{})
+	to := buildIndex(t, "4505,999999\n0322,156160\n4617,123456\n", time.Time{})
+
+	var diff bytes.Buffer
+	_, err := Diff(from, to, &diff)
+	require.NoError(t, err)
+
+	var applied bytes.Buffer
+	stats, err := ApplyDiff(from, &diff, &applied)
+	require.NoError(t, err)
+	assert.Equal(t, DiffStats{Added: 2}, stats)
+}
+
+func Test_ApplyDiffFileVerifiesBase(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	src := filepath.Join(dir, "list.csv")
+	base, target := filepath.Join(dir, "base.idx"), filepath.Join(dir, "target.idx")
+	require.NoError(t, os.WriteFile(src, []byte("4617,123456\n"), 0o600))
+	_, err := BuildFile(src, base, time.Time{})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(src, []byte("4617,123456\n4617,000001\n"), 0o600))
+	_, err = BuildFile(src, target, time.Time{})
+	require.NoError(t, err)
+
+	from, err := Open(base)
+	require.NoError(t, err)
+	to, err := Open(target)
+	require.NoError(t, err)
+	var diff bytes.Buffer
+	_, err = Diff(from, to, &diff)
+	require.NoError(t, err)
+	require.NoError(t, from.Close())
+	require.NoError(t, to.Close())
+	diffPath := filepath.Join(dir, "changes.diff")
+	require.NoError(t, os.WriteFile(diffPath, diff.Bytes(), 0o600))
+
+	// Портим номер в данных серии, контрольная сумма в метаданных остается прежней
+	data, err := os.ReadFile(base)
+	require.NoError(t, err)
+	data[headerSize+entrySize]++
+	require.NoError(t, os.WriteFile(base, data, 0o600))
+
+	_, err = ApplyDiffFile(base, diffPath, filepath.Join(dir, "applied.idx"))
+	assert.ErrorIs(t, err, ErrChecksumMismatch)
+	assert.NoFileExists(t, filepath.Join(dir, "applied.idx"))
+}
+
+func Test_IndexVerify(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	_, err := Build(strings.NewReader("4617,123456\n"), &buf, time.Time{})
+	require.NoError(t, err)
+
+	data := buf.Bytes()
+	// Номер 123456 в массиве серии, подменяем его на другой
+	data[headerSize+entrySize]++
+
+	idx, err := NewIndex(data)
+	require.NoError(t, err)
+	assert.ErrorIs(t, idx.Verify(), ErrChecksumMismatch)
+}
+
+func Test_IndexVerifySourceDate(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	_, err := Build(strings.NewReader("4617,123456\n"), &buf, time.Date(2024, 2, 27, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	data := buf.Bytes()
+	// Дата выгрузки — первые 8 байт метаданных, сдвигаем ее на сутки
+	trailer := data[len(data)-trailerSize:]
+	binary.LittleEndian.PutUint64(trailer, binary.LittleEndian.Uint64(trailer)+24*60*60)
+
+	idx, err := NewIndex(data)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 2, 28, 0, 0, 0, 0, time.UTC), idx.Metadata().SourceDate)
+	assert.ErrorIs(t, idx.Verify(), ErrChecksumMismatch)
+}
+
+func Test_StoreSwap(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	src := filepath.Join(dir, "list.csv")
+	first, second := filepath.Join(dir, "first.idx"), filepath.Join(dir, "second.idx")
+
+	require.NoError(t, os.WriteFile(src, []byte("PASSP_SERIES,PASSP_NUMBER\n4617,123456\n"), 0o600))
+	_, err := BuildFile(src, first, time.Date(2024, 2, 27, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(src, []byte("PASSP_SERIES,PASSP_NUMBER\n4617,000001\n"), 0o600))
+	_, err = BuildFile(src, second, time.Date(2024, 2, 28, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	store, err := OpenStore(first)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+
+	firstMeta, err := store.Check("4617", "123456")
+	assert.ErrorIs(t, err, passport_validator.ErrPassportListedInvalid)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				listed, meta, err := store.IsListedInvalid("4617", "123456")
+				if !assert.NoError(t, err) {
+					return
+				}
+				// Ответ всегда соответствует снимку, по которому получен
+				assert.Equal(t, meta.Checksum == firstMeta.Checksum, listed)
+			}
+		}()
+	}
+
+	secondMeta, err := store.Load(second)
+	require.NoError(t, err)
+	wg.Wait()
+
+	assert.NotEqual(t, firstMeta.Checksum, secondMeta.Checksum)
+	assert.Equal(t, time.Date(2024, 2, 28, 0, 0, 0, 0, time.UTC), secondMeta.SourceDate)
+
+	meta, err := store.Check("4617", "000001")
+	assert.ErrorIs(t, err, passport_validator.ErrPassportListedInvalid)
+	assert.Equal(t, secondMeta, meta)
+
+	_, err = store.Check("4617", "123456")
+	assert.NoError(t, err)
+}
+
+func Test_StoreLoadCorruptedKeepsCurrent(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	good, broken := filepath.Join(dir, "good.idx"), filepath.Join(dir, "broken.idx")
+
+	var buf bytes.Buffer
+	_, err := Build(strings.NewReader("4617,123456\n"), &buf, time.Time{})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(good, buf.Bytes(), 0o600))
+
+	data := buf.Bytes()
+	data[headerSize+entrySize]++
+	require.NoError(t, os.WriteFile(broken, data, 0o600))
+
+	store, err := OpenStore(good)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+
+	_, err = store.Load(broken)
+	assert.ErrorIs(t, err, ErrChecksumMismatch)
+
+	_, err = store.Check("4617", "123456")
+	assert.ErrorIs(t, err, passport_validator.ErrPassportListedInvalid)
+}
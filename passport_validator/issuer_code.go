@@ -0,0 +1,79 @@
+package passport_validator
+
+import (
+	"strings"
+)
+
+// IssuerLevel уровень подразделения, закодированный третьей цифрой кода подразделения
+type IssuerLevel int
+
+const (
+	// IssuerLevelSubject подразделение уровня субъекта РФ
+	IssuerLevelSubject IssuerLevel = 0
+	// IssuerLevelCity подразделение уровня города
+	IssuerLevelCity IssuerLevel = 1
+	// IssuerLevelDistrict подразделение уровня района
+	IssuerLevelDistrict IssuerLevel = 2
+	// IssuerLevelCityDistrict подразделение уровня района в городе
+	IssuerLevelCityDistrict IssuerLevel = 3
+)
+
+func (l IssuerLevel) String() string {
+	switch l {
+	case IssuerLevelSubject:
+		return "subject"
+	case IssuerLevelCity:
+		return "city"
+	case IssuerLevelDistrict:
+		return "district"
+	case IssuerLevelCityDistrict:
+		return "city_district"
+	default:
+		return "unknown"
+	}
+}
+
+// valid true для уровней, которые используются в кодах подразделений
+func (l IssuerLevel) valid() bool {
+	return l >= IssuerLevelSubject && l <= IssuerLevelCityDistrict
+}
+
+// IssuerCode разобранный код подразделения 000-000
+type IssuerCode struct {
+	// Region номер субъекта РФ, первые две цифры кода
+	Region string
+	// Level уровень подразделения, третья цифра кода
+	Level IssuerLevel
+	// Unit номер подразделения, последние три цифры кода
+	Unit string
+}
+
+// ParseIssuerCode разбирает код подразделения в формате 000-000 или 000000
+func ParseIssuerCode(issuedCode string) (IssuerCode, error) {
+	if err := IsPassportIssuerCodeValid(issuedCode); err != nil {
+		return IssuerCode{}, err
+	}
+
+	code := strings.ReplaceAll(issuedCode, "-", "")
+	issuerCode := IssuerCode{
+		Region: code[:2],
+		Level:  IssuerLevel(code[2] - '0'),
+		Unit:   code[3:],
+	}
+	if !issuerCode.Level.valid() {
+		return IssuerCode{}, ErrInvalidIssuedCodeLevel
+	}
+	return issuerCode, nil
+}
+
+// String код подразделения в формате 000-000
+func (c IssuerCode) String() string {
+	return c.Region + string(rune('0'+c.Level)) + "-" + c.Unit
+}
+
+// IsPassportIssuerCodeStrictValid в дополнение к IsPassportIssuerCodeValid проверяет,
+// что третья цифра кода — существующий уровень подразделения
+func IsPassportIssuerCodeStrictValid(issuedCode string) error {
+	_, err := ParseIssuerCode(issuedCode)
+	return err
+}
@@ -0,0 +1,84 @@
+package passport_validator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseIssuerCode(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		issuedCode string
+		want       IssuerCode
+		wantErr    error
+	}{
+		"subject level": {
+			issuedCode: "500-001",
+			want:       IssuerCode{Region: "50", Level: IssuerLevelSubject, Unit: "001"},
+		},
+		"city district level without hyphen": {
+			issuedCode: "773005",
+			want:       IssuerCode{Region: "77", Level: IssuerLevelCityDistrict, Unit: "005"},
+		},
+		"district level": {
+			issuedCode: "612-034",
+			want:       IssuerCode{Region: "61", Level: IssuerLevelDistrict, Unit: "034"},
+		},
+		"impossible level": {
+			issuedCode: "507-001",
+			wantErr:    ErrInvalidIssuedCodeLevel,
+		},
+		"wrong format": {
+			issuedCode: "50-0001",
+			wantErr:    ErrInvalidIssuedCode,
+		},
+		"empty": {
+			issuedCode: "",
+			wantErr:    ErrEmptyIssuedCode,
+		},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseIssuerCode(tt.issuedCode)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorIs(t, IsPassportIssuerCodeStrictValid(tt.issuedCode), tt.wantErr)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.NoError(t, IsPassportIssuerCodeStrictValid(tt.issuedCode))
+		})
+	}
+}
+
+func Test_IssuerCodeString(t *testing.T) {
+	t.Parallel()
+
+	code, err := ParseIssuerCode("772019")
+	require.NoError(t, err)
+
+	assert.Equal(t, "772-019", code.String())
+	assert.Equal(t, "district", code.Level.String())
+}
+
+func Test_PassportValidateIssuerCodeLevel(t *testing.T) {
+	t.Parallel()
+
+	p := validPassport()
+	p.IssuerCode = "509-001"
+
+	// Нестрогая проверка формата пропускает код, проверка паспорта — нет
+	assert.NoError(t, IsPassportIssuerCodeValid(p.IssuerCode))
+	assert.ErrorIs(t, p.Validate(time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC)), ErrInvalidIssuedCodeLevel)
+}
@@ -0,0 +1,213 @@
+package passport_validator
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Issuer подразделение, выдающее паспорта
+type Issuer struct {
+	// Code код подразделения в формате 000-000
+	Code   string
+	Name   string
+	Region string
+	// ValidFrom дата, с которой подразделение выдает паспорта, нулевая если неизвестна
+	ValidFrom time.Time
+	// ValidTo дата, с которой подразделение больше не выдает паспорта, нулевая если действует сейчас
+	ValidTo time.Time
+}
+
+// activeAt true, если подразделение действовало на дату date
+func (i Issuer) activeAt(date time.Time) bool {
+	if !i.ValidFrom.IsZero() && date.Before(i.ValidFrom) {
+		return false
+	}
+	if !i.ValidTo.IsZero() && !date.Before(i.ValidTo) {
+		return false
+	}
+	return true
+}
+
+// IssuerDirectory справочник кодов подразделений. Безопасен для конкурентного использования,
+// содержимое можно обновить через Replace, не пересоздавая валидаторы.
+type IssuerDirectory struct {
+	mu      sync.RWMutex
+	issuers map[string][]Issuer
+}
+
+// NewIssuerDirectory создает справочник из списка подразделений
+func NewIssuerDirectory(issuers ...Issuer) *IssuerDirectory {
+	d := &IssuerDirectory{issuers: make(map[string][]Issuer, len(issuers))}
+	for _, issuer := range issuers {
+		issuer.Code = normalizeIssuerCode(issuer.Code)
+		d.issuers[issuer.Code] = append(d.issuers[issuer.Code], issuer)
+	}
+	return d
+}
+
+// ReadIssuerDirectory читает справочник в формате CSV с разделителем ";" и заголовком
+// code;name;region;valid_from;valid_to. Даты в формате 2006-01-02, пустые если неизвестны.
+func ReadIssuerDirectory(r io.Reader) (*IssuerDirectory, error) {
+	reader := csv.NewReader(r)
+	reader.Comma = ';'
+	reader.FieldsPerRecord = 5
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return NewIssuerDirectory(), nil
+	}
+
+	issuers := make([]Issuer, 0, len(records)-1)
+	// Первая строка — заголовок
+	for _, record := range records[1:] {
+		if !issuedCodeRegexp.MatchString(record[0]) {
+			return nil, fmt.Errorf("issuer %q: %w", record[0], ErrInvalidIssuedCode)
+		}
+		validFrom, err := parseOptionalDate(record[3])
+		if err != nil {
+			return nil, fmt.Errorf("issuer %s: %w", record[0], err)
+		}
+		validTo, err := parseOptionalDate(record[4])
+		if err != nil {
+			return nil, fmt.Errorf("issuer %s: %w", record[0], err)
+		}
+
+		issuers = append(issuers, Issuer{
+			Code:      record[0],
+			Name:      record[1],
+			Region:    record[2],
+			ValidFrom: validFrom,
+			ValidTo:   validTo,
+		})
+	}
+	return NewIssuerDirectory(issuers...), nil
+}
+
+// LoadIssuerDirectory читает справочник из локального CSV файла, см. ReadIssuerDirectory
+func LoadIssuerDirectory(path string) (*IssuerDirectory, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ReadIssuerDirectory(f)
+}
+
+// Replace заменяет содержимое справочника содержимым other
+func (d *IssuerDirectory) Replace(other *IssuerDirectory) {
+	other.mu.RLock()
+	issuers := other.issuers
+	other.mu.RUnlock()
+
+	d.mu.Lock()
+	d.issuers = issuers
+	d.mu.Unlock()
+}
+
+// Len количество кодов подразделений в справочнике
+func (d *IssuerDirectory) Len() int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return len(d.issuers)
+}
+
+// Lookup подразделение по коду, для кодов с несколькими записями — последняя
+func (d *IssuerDirectory) Lookup(code string) (Issuer, error) {
+	issuers, err := d.lookup(code)
+	if err != nil {
+		return Issuer{}, err
+	}
+	return issuers[len(issuers)-1], nil
+}
+
+// LookupAt подразделение по коду, действовавшее на дату date
+func (d *IssuerDirectory) LookupAt(code string, date time.Time) (Issuer, error) {
+	issuers, err := d.lookup(code)
+	if err != nil {
+		return Issuer{}, err
+	}
+	for _, issuer := range issuers {
+		if issuer.activeAt(date) {
+			return issuer, nil
+		}
+	}
+	return Issuer{}, ErrIssuedCodeNotActive
+}
+
+func (d *IssuerDirectory) lookup(code string) ([]Issuer, error) {
+	if code == "" {
+		return nil, ErrEmptyIssuedCode
+	}
+	if !issuedCodeRegexp.MatchString(code) {
+		return nil, ErrInvalidIssuedCode
+	}
+
+	d.mu.RLock()
+	issuers, ok := d.issuers[normalizeIssuerCode(code)]
+	loaded := len(d.issuers) > 0
+	d.mu.RUnlock()
+
+	if !loaded {
+		return nil, ErrIssuerDirectoryNotLoaded
+	}
+	if !ok {
+		return nil, ErrUnknownIssuedCode
+	}
+	return issuers, nil
+}
+
+// normalizeIssuerCode приводит код подразделения к виду 000-000
+func normalizeIssuerCode(code string) string {
+	code = strings.ReplaceAll(code, "-", "")
+	if len(code) != 6 {
+		return code
+	}
+	return code[:3] + "-" + code[3:]
+}
+
+// defaultIssuerDirectory справочник функций пакета. Данные о подразделениях с пакетом не поставляются,
+// он пуст, пока в него не загрузят справочник МВД через Replace.
+var defaultIssuerDirectory = NewIssuerDirectory()
+
+// DefaultIssuerDirectory справочник, который используется LookupIssuer и IsPassportIssuerCodeKnown.
+// Пакет не содержит кодов подразделений: загрузите справочник через LoadIssuerDirectory и Replace.
+func DefaultIssuerDirectory() *IssuerDirectory {
+	return defaultIssuerDirectory
+}
+
+// LookupIssuer подразделение по коду в справочнике по умолчанию. Пока справочник не загружен,
+// возвращает ErrIssuerDirectoryNotLoaded.
+func LookupIssuer(code string) (Issuer, error) {
+	return defaultIssuerDirectory.Lookup(code)
+}
+
+// IsPassportIssuerCodeKnown проверяет, что код подразделения есть в справочнике по умолчанию
+// и подразделение действовало на дату выдачи issueDate
+func IsPassportIssuerCodeKnown(issuedCode string, issueDate time.Time) error {
+	return defaultValidator.IsPassportIssuerCodeKnown(issuedCode, issueDate)
+}
+
+// IsPassportIssuerCodeKnown проверяет код по справочнику из WithIssuerDirectory, без него — по справочнику
+// по умолчанию
+func (v *Validator) IsPassportIssuerCodeKnown(issuedCode string, issueDate time.Time) error {
+	directory := v.issuerDirectory
+	if directory == nil {
+		directory = defaultIssuerDirectory
+	}
+	if issueDate.IsZero() {
+		_, err := directory.Lookup(issuedCode)
+		return err
+	}
+	_, err := directory.LookupAt(issuedCode, issueDate)
+	return err
+}
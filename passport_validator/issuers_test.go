@@ -0,0 +1,145 @@
+package passport_validator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testIssuersCSV = `code;name;region;valid_from;valid_to
+500-001;ТП УФМС России по Московской обл.;Московская область;2006-01-01;
+500-002;ОВД г. Подольска;Московская область;;2006-01-01
+500002;ТП УФМС России по Московской обл. в г. Подольске;Московская область;2006-01-01;
+`
+
+func Test_IssuerDirectoryLookup(t *testing.T) {
+	t.Parallel()
+
+	directory, err := ReadIssuerDirectory(strings.NewReader(testIssuersCSV))
+	require.NoError(t, err)
+	require.Equal(t, 2, directory.Len())
+
+	testCases := map[string]struct {
+		code     string
+		date     time.Time
+		wantName string
+		wantErr  error
+	}{
+		"code with hyphen": {
+			code:     "500-001",
+			date:     time.Date(2017, 2, 20, 0, 0, 0, 0, time.UTC),
+			wantName: "ТП УФМС России по Московской обл.",
+		},
+		"code without hyphen": {
+			code:     "500001",
+			date:     time.Date(2017, 2, 20, 0, 0, 0, 0, time.UTC),
+			wantName: "ТП УФМС России по Московской обл.",
+		},
+		"historical record": {
+			code:     "500-002",
+			date:     time.Date(2003, 2, 20, 0, 0, 0, 0, time.UTC),
+			wantName: "ОВД г. Подольска",
+		},
+		"current record": {
+			code:     "500-002",
+			date:     time.Date(2006, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantName: "ТП УФМС России по Московской обл. в г. Подольске",
+		},
+		"not active yet": {
+			code:    "500-001",
+			date:    time.Date(2003, 2, 20, 0, 0, 0, 0, time.UTC),
+			wantErr: ErrIssuedCodeNotActive,
+		},
+		"unknown code": {
+			code:    "500-003",
+			date:    time.Date(2017, 2, 20, 0, 0, 0, 0, time.UTC),
+			wantErr: ErrUnknownIssuedCode,
+		},
+		"invalid code": {
+			code:    "50-001",
+			date:    time.Date(2017, 2, 20, 0, 0, 0, 0, time.UTC),
+			wantErr: ErrInvalidIssuedCode,
+		},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			issuer, err := directory.LookupAt(tt.code, tt.date)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantName, issuer.Name)
+			assert.Equal(t, "Московская область", issuer.Region)
+		})
+	}
+}
+
+func Test_LoadIssuerDirectory(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "issuers.csv")
+	require.NoError(t, os.WriteFile(path, []byte(testIssuersCSV), 0o600))
+
+	directory, err := LoadIssuerDirectory(path)
+	require.NoError(t, err)
+
+	issuer, err := directory.Lookup("500-002")
+	require.NoError(t, err)
+	assert.Equal(t, "ТП УФМС России по Московской обл. в г. Подольске", issuer.Name)
+
+	_, err = LoadIssuerDirectory(filepath.Join(t.TempDir(), "missing.csv"))
+	assert.Error(t, err)
+
+	_, err = ReadIssuerDirectory(strings.NewReader("code;name;region;valid_from;valid_to\n50-001;x;y;;\n"))
+	assert.ErrorIs(t, err, ErrInvalidIssuedCode)
+}
+
+func Test_IssuerDirectoryReplace(t *testing.T) {
+	t.Parallel()
+
+	directory := NewIssuerDirectory()
+	_, err := directory.Lookup("500-001")
+	assert.ErrorIs(t, err, ErrIssuerDirectoryNotLoaded)
+
+	directory.Replace(NewIssuerDirectory(Issuer{Code: "500001", Name: "ТП"}))
+
+	issuer, err := directory.Lookup("500-001")
+	require.NoError(t, err)
+	assert.Equal(t, "500-001", issuer.Code)
+}
+
+func Test_ValidatorIssuerDirectory(t *testing.T) {
+	t.Parallel()
+
+	checkDate := time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC)
+	directory, err := ReadIssuerDirectory(strings.NewReader(testIssuersCSV))
+	require.NoError(t, err)
+
+	p := validPassport()
+	p.IssuerCode = "500-003"
+
+	// Без WithIssuerDirectory проверяется только формат кода
+	assert.Empty(t, NewValidator(WithClock(fixedClock(checkDate))).CheckPassport(p).Errors)
+
+	// Справочник задан, но не загружен — код проверить нельзя
+	empty := NewValidator(WithClock(fixedClock(checkDate)), WithIssuerDirectory(NewIssuerDirectory()))
+	assert.ErrorIs(t, empty.ValidatePassport(p), ErrIssuerDirectoryNotLoaded)
+
+	v := NewValidator(WithClock(fixedClock(checkDate)), WithIssuerDirectory(directory))
+	assert.ErrorIs(t, v.ValidatePassport(p), ErrUnknownIssuedCode)
+
+	p.IssuerCode = "500-001"
+	assert.NoError(t, v.ValidatePassport(p))
+}
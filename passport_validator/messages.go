@@ -0,0 +1,282 @@
+package passport_validator
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Lang язык сообщений об ошибках
+type Lang string
+
+const (
+	LangRU Lang = "ru"
+	LangEN Lang = "en"
+)
+
+// Параметры, которые подставляются в сообщения вместо {name}
+const (
+	ParamExpirationDate = "expiration_date"
+	ParamReplacementAge = "replacement_age"
+)
+
+// BareMessageSuffix суффикс кода для текста без параметров. Он используется, когда у ошибки нет
+// параметров, нужных основному сообщению, например для ошибки-сентинела без ValidationError.
+const BareMessageSuffix = ".bare"
+
+// placeholderRe находит неподставленные параметры {name}
+var placeholderRe = regexp.MustCompile(`\{[a-z_]+\}`)
+
+// defaultLang язык, на который откатываемся, если сообщения на запрошенном языке нет
+const defaultLang = LangEN
+
+var catalog = struct {
+	sync.RWMutex
+	messages map[Lang]map[string]string
+}{
+	messages: map[Lang]map[string]string{
+		LangRU: {
+			"last_name_empty":                  "Не указана фамилия",
+			"first_name_empty":                 "Не указано имя",
+			"series_empty":                     "Не указана серия паспорта",
+			"series_year_out_of_range":         "Год выпуска бланка в серии паспорта раньше 1997 года или позже допустимого",
+			"series_format":                    "Серия паспорта должна состоять из 4 цифр",
+			"number_empty":                     "Не указан номер паспорта",
+			"number_format":                    "Номер паспорта должен состоять из 6 цифр",
+			"issue_date_before_14":             "Паспорт выдан до 14 лет",
+			"issue_date_empty":                 "Не указана дата выдачи паспорта",
+			"birthday_empty":                   "Не указана дата рождения",
+			"issuer_code_empty":                "Не указан код подразделения",
+			"issuer_code_format":               "Код подразделения должен состоять из 6 цифр в формате 000-000",
+			"birthday_invalid":                 "Дата рождения не указана, в будущем или владельцу нет 18 лет",
+			"non_cyrillic_character":           "Допустимы только буквы русского алфавита",
+			"issue_date_in_future":             "Дата выдачи паспорта в будущем",
+			"expired_at_20":                    "Паспорт недействителен после {expiration_date}: требуется замена по достижении 20 лет",
+			"expired_at_45":                    "Паспорт недействителен после {expiration_date}: требуется замена по достижении 45 лет",
+			"expired_at_20.bare":               "Паспорт недействителен: требуется замена по достижении 20 лет",
+			"expired_at_45.bare":               "Паспорт недействителен: требуется замена по достижении 45 лет",
+			"expired_at_age":                   "Паспорт недействителен после {expiration_date}: требуется замена по достижении {replacement_age} лет",
+			"expired_at_age.bare":              "Паспорт недействителен: истек срок замены по возрасту",
+			"series_year_after_issue_date":     "Год бланка в серии паспорта позже года выдачи",
+			"series_year_too_old":              "Год бланка в серии паспорта слишком далек от года выдачи",
+			"series_region_unknown":            "Первые две цифры серии паспорта не соответствуют коду субъекта РФ",
+			"issuer_code_unknown":              "Код подразделения не найден в справочнике",
+			"issuer_directory_not_loaded":      "Справочник подразделений не загружен, код подразделения не проверен",
+			"issuer_code_not_active":           "Подразделение с таким кодом не выдавало паспорта на дату выдачи",
+			"region_mismatch":                  "Регион в серии паспорта не совпадает с регионом подразделения, выдавшего паспорт",
+			"issuer_code_level":                "Третья цифра кода подразделения не соответствует уровню подразделения",
+			"listed_invalid":                   "Паспорт числится в списке недействительных паспортов МВД",
+			"mrz_format":                       "Машиночитаемая запись не соответствует формату паспорта гражданина РФ",
+			"mrz_check_digit":                  "Контрольная цифра машиночитаемой записи не совпадает",
+			"foreign_series_format":            "Серия заграничного паспорта должна состоять из 2 цифр",
+			"foreign_number_format":            "Номер заграничного паспорта должен состоять из 7 цифр",
+			"foreign_type_unknown":             "Вид заграничного паспорта не указан или неизвестен",
+			"non_latin_character":              "Допустимы только буквы латинского алфавита",
+			"expiry_date_empty":                "Не указан срок действия",
+			"foreign_expiry_mismatch":          "Срок действия не соответствует виду заграничного паспорта",
+			"foreign_expired":                  "Срок действия заграничного паспорта истек",
+			"issue_date_before_birthday":       "Дата выдачи раньше даты рождения",
+			"birth_certificate_series_empty":   "Не указана серия свидетельства о рождении",
+			"birth_certificate_series_format":  "Серия свидетельства о рождении должна быть в формате IV-МЮ",
+			"roman_numeral_invalid":            "Римская часть серии свидетельства о рождении записана неверно",
+			"birth_certificate_number_empty":   "Не указан номер свидетельства о рождении",
+			"birth_certificate_number_format":  "Номер свидетельства о рождении должен состоять из 6 цифр",
+			"soviet_passport_series":           "Это серия паспорта СССР образца 1974 года, такие паспорта недействительны с 1 июля 2004 года",
+			"soviet_series_format":             "Серия паспорта СССР должна быть в формате IV-МЮ",
+			"soviet_number_format":             "Номер паспорта СССР должен состоять из 6 цифр",
+			"soviet_issue_date_before_16":      "Паспорт СССР выдан до 16 лет",
+			"soviet_passport_not_valid":        "Паспорта СССР образца 1974 года недействительны с 1 июля 2004 года",
+			"soviet_issue_date_after_deadline": "Паспорта СССР не выдавались после 1 июля 2004 года",
+			"temporary_id_number_empty":        "Не указан номер временного удостоверения",
+			"temporary_id_number_format":       "Номер временного удостоверения должен состоять из 6–12 цифр",
+			"temporary_id_validity_too_long":   "Срок действия временного удостоверения больше допустимого",
+			"temporary_id_expiry_before_issue": "Срок действия временного удостоверения раньше даты выдачи",
+			"temporary_id_extension_invalid":   "Неверная отметка о продлении временного удостоверения",
+			"temporary_id_expired":             "Срок действия временного удостоверения истек",
+			"residence_permit_series_empty":    "Не указана серия вида на жительство",
+			"residence_permit_series_format":   "Серия вида на жительство должна быть 82 или 83",
+			"residence_permit_number_empty":    "Не указан номер вида на жительство",
+			"residence_permit_number_format":   "Номер вида на жительство должен состоять из 7 цифр",
+			"residence_permit_expiry_mismatch": "Срок действия не соответствует виду на жительство",
+			"residence_permit_expired":         "Срок действия вида на жительство истек",
+			"temp_residence_number_empty":      "Не указан номер решения о разрешении на временное проживание",
+			"temp_residence_number_format":     "Номер решения о разрешении на временное проживание должен состоять из цифр",
+			"temp_residence_expiry_mismatch":   "Разрешение на временное проживание выдается на 3 года",
+			"temp_residence_expired":           "Срок действия разрешения на временное проживание истек",
+			"document_type_unsupported":        "Этот тип документа не поддерживается",
+			"document_type_unknown":            "Неизвестный тип документа",
+			"document_payload_invalid":         "Неверный формат данных документа",
+			"document_field_unknown":           "Неизвестное поле документа",
+			"date_format":                      "Дата должна быть в формате ГГГГ-ММ-ДД",
+			"military_id_series_empty":         "Не указана серия военного билета",
+			"military_id_series_format":        "Серия военного билета должна состоять из 2 букв русского алфавита",
+			"military_id_number_empty":         "Не указан номер военного билета",
+			"military_id_number_format":        "Номер военного билета должен состоять из 7 цифр",
+			"military_id_issue_date_before_18": "Военный билет выдан до 18 лет",
+			"driver_license_series_empty":      "Не указана серия водительского удостоверения",
+			"driver_license_series_format":     "Серия водительского удостоверения должна состоять из 4 цифр или 2 цифр и 2 букв",
+			"driver_license_number_empty":      "Не указан номер водительского удостоверения",
+			"driver_license_number_format":     "Номер водительского удостоверения должен состоять из 6 цифр",
+			"driver_license_before_16":         "Водительское удостоверение выдано до 16 лет",
+			"driver_license_expiry_mismatch":   "Водительское удостоверение выдается на 10 лет",
+			"driver_license_expired":           "Срок действия водительского удостоверения истек",
+		},
+		LangEN: {
+			"last_name_empty":                  "Last name is required",
+			"first_name_empty":                 "First name is required",
+			"series_empty":                     "Passport series is required",
+			"series_year_out_of_range":         "Blank year in the passport series is before 1997 or too far in the future",
+			"series_format":                    "Passport series must be 4 digits",
+			"number_empty":                     "Passport number is required",
+			"number_format":                    "Passport number must be 6 digits",
+			"issue_date_before_14":             "Passport was issued before the 14th birthday",
+			"issue_date_empty":                 "Passport issue date is required",
+			"birthday_empty":                   "Birthday is required",
+			"issuer_code_empty":                "Issuer code is required",
+			"issuer_code_format":               "Issuer code must be 6 digits in 000-000 format",
+			"birthday_invalid":                 "Birthday is empty, in the future or the holder is under 18",
+			"non_cyrillic_character":           "Only Cyrillic letters are allowed",
+			"issue_date_in_future":             "Passport issue date is in the future",
+			"expired_at_20":                    "Passport is not valid after {expiration_date}: it must be replaced at the age of 20",
+			"expired_at_45":                    "Passport is not valid after {expiration_date}: it must be replaced at the age of 45",
+			"expired_at_20.bare":               "Passport is not valid: it must be replaced at the age of 20",
+			"expired_at_45.bare":               "Passport is not valid: it must be replaced at the age of 45",
+			"expired_at_age":                   "Passport is not valid after {expiration_date}: it must be replaced at the age of {replacement_age}",
+			"expired_at_age.bare":              "Passport is not valid: it is past the replacement age",
+			"series_year_after_issue_date":     "Blank year in the passport series is after the issue year",
+			"series_year_too_old":              "Blank year in the passport series is too old for the issue date",
+			"series_region_unknown":            "The first two digits of the passport series are not a known region code",
+			"issuer_code_unknown":              "Issuer code is not found in the directory",
+			"issuer_directory_not_loaded":      "Issuer directory is not loaded, the issuer code was not checked",
+			"issuer_code_not_active":           "Issuer with this code did not issue passports on the issue date",
+			"region_mismatch":                  "Region in the passport series does not match the issuer region",
+			"issuer_code_level":                "The third digit of the issuer code is not a valid division level",
+			"listed_invalid":                   "Passport is in the MVD list of invalid passports",
+			"mrz_format":                       "Machine-readable zone does not match the Russian internal passport format",
+			"mrz_check_digit":                  "Machine-readable zone check digit does not match",
+			"foreign_series_format":            "Foreign passport series must be 2 digits",
+			"foreign_number_format":            "Foreign passport number must be 7 digits",
+			"foreign_type_unknown":             "Foreign passport type is missing or unknown",
+			"non_latin_character":              "Only Latin letters are allowed",
+			"expiry_date_empty":                "Expiry date is required",
+			"foreign_expiry_mismatch":          "Expiry date does not match the foreign passport type",
+			"foreign_expired":                  "Foreign passport has expired",
+			"issue_date_before_birthday":       "Issue date is before the birthday",
+			"birth_certificate_series_empty":   "Birth certificate series is required",
+			"birth_certificate_series_format":  "Birth certificate series must look like IV-МЮ",
+			"roman_numeral_invalid":            "The Roman numeral in the birth certificate series is not valid",
+			"birth_certificate_number_empty":   "Birth certificate number is required",
+			"birth_certificate_number_format":  "Birth certificate number must be 6 digits",
+			"soviet_passport_series":           "This is a 1974 Soviet passport series, such passports are not valid since July 1, 2004",
+			"soviet_series_format":             "Soviet passport series must look like IV-МЮ",
+			"soviet_number_format":             "Soviet passport number must be 6 digits",
+			"soviet_issue_date_before_16":      "Soviet passport was issued before the 16th birthday",
+			"soviet_passport_not_valid":        "1974 Soviet passports are not valid since July 1, 2004",
+			"soviet_issue_date_after_deadline": "Soviet passports were not issued after July 1, 2004",
+			"temporary_id_number_empty":        "Temporary ID number is required",
+			"temporary_id_number_format":       "Temporary ID number must be 6 to 12 digits",
+			"temporary_id_validity_too_long":   "Temporary ID expiry date exceeds the validity period",
+			"temporary_id_expiry_before_issue": "Temporary ID expiry date is before the issue date",
+			"temporary_id_extension_invalid":   "Temporary ID extension is invalid",
+			"temporary_id_expired":             "Temporary ID has expired",
+			"residence_permit_series_empty":    "Residence permit series is required",
+			"residence_permit_series_format":   "Residence permit series must be 82 or 83",
+			"residence_permit_number_empty":    "Residence permit number is required",
+			"residence_permit_number_format":   "Residence permit number must be 7 digits",
+			"residence_permit_expiry_mismatch": "Expiry date does not match the residence permit",
+			"residence_permit_expired":         "Residence permit has expired",
+			"temp_residence_number_empty":      "Temporary residence permit decision number is required",
+			"temp_residence_number_format":     "Temporary residence permit decision number must be digits",
+			"temp_residence_expiry_mismatch":   "Temporary residence permit is issued for 3 years",
+			"temp_residence_expired":           "Temporary residence permit has expired",
+			"document_type_unsupported":        "This document type is not supported",
+			"document_type_unknown":            "Unknown document type",
+			"document_payload_invalid":         "Invalid document data format",
+			"document_field_unknown":           "Unknown document field",
+			"date_format":                      "Date must be in YYYY-MM-DD format",
+			"military_id_series_empty":         "Military ID series is required",
+			"military_id_series_format":        "Military ID series must be 2 Cyrillic letters",
+			"military_id_number_empty":         "Military ID number is required",
+			"military_id_number_format":        "Military ID number must be 7 digits",
+			"military_id_issue_date_before_18": "Military ID was issued before the 18th birthday",
+			"driver_license_series_empty":      "Driver license series is required",
+			"driver_license_series_format":     "Driver license series must be 4 digits or 2 digits and 2 letters",
+			"driver_license_number_empty":      "Driver license number is required",
+			"driver_license_number_format":     "Driver license number must be 6 digits",
+			"driver_license_before_16":         "Driver license was issued before the 16th birthday",
+			"driver_license_expiry_mismatch":   "Driver license is issued for 10 years",
+			"driver_license_expired":           "Driver license has expired",
+		},
+	},
+}
+
+// RegisterMessages добавляет или переопределяет сообщения для языка lang. Ключ — код ошибки (см. ErrorCode),
+// значение — текст, в котором {name} заменяется на параметр ошибки. Текст без параметров для случая,
+// когда их нет у ошибки, регистрируется под кодом с суффиксом BareMessageSuffix.
+func RegisterMessages(lang Lang, messages map[string]string) {
+	catalog.Lock()
+	defer catalog.Unlock()
+
+	if catalog.messages[lang] == nil {
+		catalog.messages[lang] = make(map[string]string, len(messages))
+	}
+	for code, message := range messages {
+		catalog.messages[lang][code] = message
+	}
+}
+
+// Localize возвращает текст ошибки на языке lang. Если перевода нет, используется английский,
+// а для ошибок не из пакета — err.Error(). Для ValidationErrors сообщения объединяются через "; ".
+// Если у ошибки нет параметров для сообщения, берется текст без параметров (см. BareMessageSuffix).
+func Localize(err error, lang Lang) string {
+	if err == nil {
+		return ""
+	}
+
+	var errs ValidationErrors
+	if errors.As(err, &errs) {
+		messages := make([]string, 0, len(errs))
+		for _, e := range errs {
+			messages = append(messages, Localize(e, lang))
+		}
+		return strings.Join(messages, "; ")
+	}
+
+	var params map[string]string
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		params = validationErr.Params
+	}
+
+	code := ErrorCode(err)
+	message, ok := lookupMessage(code, lang)
+	if !ok {
+		return err.Error()
+	}
+	for name, value := range params {
+		message = strings.ReplaceAll(message, "{"+name+"}", value)
+	}
+	if placeholderRe.MatchString(message) {
+		if bare, ok := lookupMessage(code+BareMessageSuffix, lang); ok {
+			return bare
+		}
+		return err.Error()
+	}
+	return message
+}
+
+func lookupMessage(code string, lang Lang) (string, bool) {
+	if code == "" {
+		return "", false
+	}
+
+	catalog.RLock()
+	defer catalog.RUnlock()
+
+	if message, ok := catalog.messages[lang][code]; ok {
+		return message, true
+	}
+	message, ok := catalog.messages[defaultLang][code]
+	return message, ok
+}
@@ -0,0 +1,118 @@
+package passport_validator
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Localize(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err  error
+		lang Lang
+		want string
+	}{
+		"sentinel ru": {
+			err:  ErrInvalidPassportNumber,
+			lang: LangRU,
+			want: "Номер паспорта должен состоять из 6 цифр",
+		},
+		"sentinel en": {
+			err:  ErrInvalidPassportNumber,
+			lang: LangEN,
+			want: "Passport number must be 6 digits",
+		},
+		"unknown language falls back to english": {
+			err:  ErrEmptyLastName,
+			lang: Lang("de"),
+			want: "Last name is required",
+		},
+		"validation error with params": {
+			err: &ValidationError{
+				Field:  FieldIssueDate,
+				Code:   "expired_at_45",
+				Params: map[string]string{ParamExpirationDate: "2000-04-01"},
+				Err:    ErrIssueDatePassportExpiredAt45,
+			},
+			lang: LangRU,
+			want: "Паспорт недействителен после 2000-04-01: требуется замена по достижении 45 лет",
+		},
+		"bare sentinel with params in message": {
+			err:  ErrIssueDatePassportExpiredAt45,
+			lang: LangRU,
+			want: "Паспорт недействителен: требуется замена по достижении 45 лет",
+		},
+		"bare sentinel with params in message en": {
+			err:  ErrIssueDatePassportExpiredAt20,
+			lang: LangEN,
+			want: "Passport is not valid: it must be replaced at the age of 20",
+		},
+		"validation error without params": {
+			err:  newValidationError(FieldIssueDate, "1999-02-20", ErrIssueDatePassportExpiredAt20),
+			lang: LangRU,
+			want: "Паспорт недействителен: требуется замена по достижении 20 лет",
+		},
+		"document-specific empty field": {
+			err:  ErrEmptyDriverLicenseNumber,
+			lang: LangRU,
+			want: "Не указан номер водительского удостоверения",
+		},
+		"foreign passport type": {
+			err:  ErrInvalidForeignPassportType,
+			lang: LangEN,
+			want: "Foreign passport type is missing or unknown",
+		},
+		"foreign error": {
+			err:  errors.New("boom"),
+			lang: LangRU,
+			want: "boom",
+		},
+		"nil": {
+			err:  nil,
+			lang: LangRU,
+			want: "",
+		},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, Localize(tt.err, tt.lang))
+		})
+	}
+}
+
+func Test_LocalizePassportValidate(t *testing.T) {
+	t.Parallel()
+
+	p := validPassport()
+	p.Series = "4699"
+	p.Number = ""
+	p.Birthday = time.Date(1955, 01, 01, 0, 0, 0, 0, time.UTC)
+	p.IssueDate = time.Date(1999, 2, 20, 0, 0, 0, 0, time.UTC)
+
+	err := p.Validate(time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC))
+	require.Error(t, err)
+	assert.Equal(t,
+		"Не указан номер паспорта; Паспорт недействителен после 2000-04-01: требуется замена по достижении 45 лет",
+		Localize(err, LangRU),
+	)
+}
+
+func Test_RegisterMessages(t *testing.T) {
+	t.Parallel()
+
+	RegisterMessages(Lang("uk"), map[string]string{
+		"number_empty": "Не вказано номер паспорта",
+	})
+
+	assert.Equal(t, "Не вказано номер паспорта", Localize(ErrEmptyPassportNumber, Lang("uk")))
+	assert.Equal(t, "Passport number must be 6 digits", Localize(ErrInvalidPassportNumber, Lang("uk")))
+}
@@ -0,0 +1,112 @@
+package passport_validator
+
+import (
+	"errors"
+	"regexp"
+	"time"
+)
+
+var (
+	// Серия военного билета — две заглавные буквы кириллицы, "АК"
+	militaryIDSeriesRegexp = regexp.MustCompile(`^[А-ЯЁ]{2}$`)
+	// Номер военного билета — 7 цифр
+	militaryIDNumberRegexp = regexp.MustCompile(`^\d{7}$`)
+)
+
+var (
+	ErrEmptyMilitaryIDSeries    = errors.New("military ID series is empty")
+	ErrInvalidMilitaryIDSeries  = errors.New("military ID series is not 2 Cyrillic letters")
+	ErrEmptyMilitaryIDNumber    = errors.New("military ID number is empty")
+	ErrInvalidMilitaryIDNumber  = errors.New("military ID number is not 7 digits")
+	ErrMilitaryIDIssuedBefore18 = errors.New("military ID issued before eighteenth birthday")
+)
+
+// MilitaryIDMinIssueAge возраст, с которого выдается военный билет: при призыве или зачислении в запас
+const MilitaryIDMinIssueAge = 18
+
+// MilitaryID данные военного билета
+type MilitaryID struct {
+	LastName   string
+	FirstName  string
+	MiddleName string
+	Series     string
+	Number     string
+	IssueDate  time.Time
+	Birthday   time.Time
+}
+
+func IsMilitaryIDSeriesValid(series string) error {
+	if series == "" {
+		return ErrEmptyMilitaryIDSeries
+	}
+	if !militaryIDSeriesRegexp.MatchString(series) {
+		return ErrInvalidMilitaryIDSeries
+	}
+	return nil
+}
+
+func IsMilitaryIDNumberValid(number string) error {
+	if number == "" {
+		return ErrEmptyMilitaryIDNumber
+	}
+	if !militaryIDNumberRegexp.MatchString(number) {
+		return ErrInvalidMilitaryIDNumber
+	}
+	return nil
+}
+
+// IsMilitaryIDIssueDateValid проверяет, что военный билет выдан не раньше 18 лет и не в будущем
+func IsMilitaryIDIssueDateValid(issueDate, birthday, checkDate time.Time) error {
+	return defaultValidator.militaryIDIssueDateValid(issueDate, birthday, checkDate)
+}
+
+// IsMilitaryIDIssueDateValid проверяет дату выдачи военного билета на текущую дату
+func (v *Validator) IsMilitaryIDIssueDateValid(issueDate, birthday time.Time) error {
+	return v.militaryIDIssueDateValid(issueDate, birthday, v.clock.Now())
+}
+
+func (v *Validator) militaryIDIssueDateValid(issueDate, birthday, checkDate time.Time) error {
+	if issueDate.IsZero() {
+		return ErrEmptyIssueDate
+	}
+	if birthday.IsZero() {
+		return ErrEmptyBirthday
+	}
+	if AgeAt(birthday, issueDate, v.leapDayPolicy).Years < MilitaryIDMinIssueAge {
+		return ErrMilitaryIDIssuedBefore18
+	}
+	if issueDate.After(checkDate) {
+		return ErrIssueDatePassportInFuture
+	}
+	return nil
+}
+
+// Validate проверяет военный билет на дату checkDate: ФИО, серию, номер и дату выдачи не раньше 18 лет
+func (m MilitaryID) Validate(checkDate time.Time) error {
+	return m.Check(checkDate).Err()
+}
+
+// Check как Validate, но возвращает ValidationResult. Срока действия у военного билета нет, поэтому
+// результат не зависит от даты проверки, кроме проверки даты выдачи в будущем.
+func (m MilitaryID) Check(checkDate time.Time) ValidationResult {
+	return defaultValidator.checkMilitaryID(m, checkDate)
+}
+
+// ValidateMilitaryID проверяет военный билет на текущую дату
+func (v *Validator) ValidateMilitaryID(m MilitaryID) error {
+	return v.checkMilitaryID(m, v.clock.Now()).Err()
+}
+
+func (v *Validator) checkMilitaryID(m MilitaryID, checkDate time.Time) ValidationResult {
+	var errs fieldErrors
+
+	errs.check(FieldLastName, m.LastName, v.IsPassportLastNameValid(m.LastName))
+	errs.check(FieldFirstName, m.FirstName, v.IsPassportFirstNameValid(m.FirstName))
+	errs.check(FieldMiddleName, m.MiddleName, v.IsPassportMiddleNameValid(m.MiddleName))
+	errs.check(FieldSeries, m.Series, IsMilitaryIDSeriesValid(m.Series))
+	errs.check(FieldNumber, m.Number, IsMilitaryIDNumberValid(m.Number))
+
+	errs.checkIssueDate(m.IssueDate, v.militaryIDIssueDateValid(m.IssueDate, m.Birthday, checkDate))
+
+	return ValidationResult{CheckDate: checkDate, Errors: ValidationErrors(errs)}
+}
@@ -0,0 +1,92 @@
+package passport_validator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func validMilitaryID() MilitaryID {
+	return MilitaryID{
+		LastName:   "Петров",
+		FirstName:  "Петр",
+		MiddleName: "Петрович",
+		Series:     "АК",
+		Number:     "1234567",
+		IssueDate:  time.Date(2016, 11, 20, 0, 0, 0, 0, time.UTC),
+		Birthday:   time.Date(1998, 4, 2, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func Test_MilitaryIDValidate(t *testing.T) {
+	t.Parallel()
+
+	checkDate := time.Date(2024, 2, 27, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]documentCase[MilitaryID]{
+		"valid": {
+			modify: func(m *MilitaryID) {},
+		},
+		"series with Ё": {
+			modify: func(m *MilitaryID) { m.Series = "ЁЖ" },
+		},
+		"empty series": {
+			modify:    func(m *MilitaryID) { m.Series = "" },
+			wantField: FieldSeries,
+			wantErr:   ErrEmptyMilitaryIDSeries,
+		},
+		"latin series": {
+			modify:    func(m *MilitaryID) { m.Series = "AK" },
+			wantField: FieldSeries,
+			wantErr:   ErrInvalidMilitaryIDSeries,
+		},
+		"lowercase series": {
+			modify:    func(m *MilitaryID) { m.Series = "ак" },
+			wantField: FieldSeries,
+			wantErr:   ErrInvalidMilitaryIDSeries,
+		},
+		"empty number": {
+			modify:    func(m *MilitaryID) { m.Number = "" },
+			wantField: FieldNumber,
+			wantErr:   ErrEmptyMilitaryIDNumber,
+		},
+		"passport number": {
+			modify:    func(m *MilitaryID) { m.Number = "123456" },
+			wantField: FieldNumber,
+			wantErr:   ErrInvalidMilitaryIDNumber,
+		},
+		"issued at 17": {
+			modify:    func(m *MilitaryID) { m.IssueDate = time.Date(2016, 4, 1, 0, 0, 0, 0, time.UTC) },
+			wantField: FieldIssueDate,
+			wantErr:   ErrMilitaryIDIssuedBefore18,
+		},
+		"issued in future": {
+			modify:    func(m *MilitaryID) { m.IssueDate = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC) },
+			wantField: FieldIssueDate,
+			wantErr:   ErrIssueDatePassportInFuture,
+		},
+		"empty birthday": {
+			modify:    func(m *MilitaryID) { m.Birthday = time.Time{} },
+			wantField: FieldBirthday,
+			wantErr:   ErrEmptyBirthday,
+		},
+		"latin name": {
+			modify:    func(m *MilitaryID) { m.LastName = "Petrov" },
+			wantField: FieldLastName,
+			wantErr:   ErrNonCyrillicCharacter,
+		},
+	}
+
+	runDocumentCases(t, validMilitaryID, checkDate, testCases)
+}
+
+func Test_MilitaryIDDocumentPayload(t *testing.T) {
+	t.Parallel()
+
+	payload := `{"type": "military_id", "fields": {"last_name": "Петров", "first_name": "Петр", "series": "АК",
+		"number": "1234567", "issue_date": "2016-11-20", "birthday": "1998-04-02"}}`
+	err := ValidateDocumentPayload(context.Background(), []byte(payload), time.Date(2024, 2, 27, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+}
@@ -0,0 +1,319 @@
+package passport_validator
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/zxcSora/passport-validator/passport_validator/translit"
+)
+
+// Машиночитаемая зона паспорта гражданина РФ (бланки с 2011 года) — две строки по 44 символа
+// формата ICAO 9303 TD3:
+//
+//	PNRUSIVANOV<<IVAN<IVANOVI3<<<<<<<<<<<<<<<<<<
+//	4611234562RUS9702204M<<<<<<<7170220500001<98
+//
+// Вторая строка: первые три цифры серии и номер, контрольная цифра, гражданство, дата рождения
+// с контрольной цифрой, пол, пустой срок действия, дополнительные данные с контрольной цифрой
+// и общая контрольная цифра. В дополнительных данных — последняя цифра серии, дата выдачи
+// и код подразделения. Имена транслитерируются по таблице МВД, см. translit.MRZ.
+const (
+	mrzLineLength = 44
+	mrzFiller     = '<'
+)
+
+// ParseMRZ разбирает машиночитаемую зону паспорта гражданина РФ, проверяя все контрольные цифры.
+// Имена восстанавливаются в кириллицу: части фамилии соединяются дефисом, первое имя после
+// фамилии становится именем, остальные — отчеством. Полученный Passport проверяется обычными
+// валидаторами, например Passport.Validate.
+func ParseMRZ(lines []string) (Passport, error) {
+	if len(lines) != 2 {
+		return Passport{}, ErrInvalidMRZ
+	}
+	line1, line2 := strings.TrimSpace(lines[0]), strings.TrimSpace(lines[1])
+	if len(line1) != mrzLineLength || len(line2) != mrzLineLength ||
+		line1[:5] != "PNRUS" || line2[10:13] != "RUS" {
+		return Passport{}, ErrInvalidMRZ
+	}
+	for _, line := range []string{line1, line2} {
+		for i := 0; i < len(line); i++ {
+			if _, ok := mrzCharValue(line[i]); !ok {
+				return Passport{}, ErrInvalidMRZ
+			}
+		}
+	}
+
+	checks := []struct {
+		name  string
+		field string
+		digit byte
+	}{
+		{name: "number", field: line2[0:9], digit: line2[9]},
+		{name: "birthday", field: line2[13:19], digit: line2[19]},
+		{name: "expiration date", field: line2[21:27], digit: line2[27]},
+		{name: "optional data", field: line2[28:42], digit: line2[42]},
+		{name: "composite", field: line2[0:10] + line2[13:20] + line2[21:43], digit: line2[43]},
+	}
+	for _, check := range checks {
+		if !mrzCheckDigitMatches(check.field, check.digit) {
+			return Passport{}, fmt.Errorf("mrz %s: %w", check.name, ErrInvalidMRZCheckDigit)
+		}
+	}
+
+	var p Passport
+	var err error
+	if p.LastName, p.FirstName, p.MiddleName, err = mrzDecodeNames(line1[5:]); err != nil {
+		return Passport{}, err
+	}
+
+	p.Series = line2[0:3] + line2[28:29]
+	p.Number = line2[3:9]
+	if !passportSeriesRegexp.MatchString(p.Series) || !passportNumberRegexp.MatchString(p.Number) {
+		return Passport{}, ErrInvalidMRZ
+	}
+
+	// Бланки с машиночитаемой зоной выдаются с 2011 года
+	if p.IssueDate, err = mrzDate(line2[29:35], 2000); err != nil {
+		return Passport{}, err
+	}
+	if p.Birthday, err = mrzBirthday(line2[13:19], p.IssueDate); err != nil {
+		return Passport{}, err
+	}
+
+	issuerCode := line2[35:41]
+	if !issuedCodeRegexp.MatchString(issuerCode) {
+		return Passport{}, ErrInvalidMRZ
+	}
+	p.IssuerCode = issuerCode[:3] + "-" + issuerCode[3:]
+
+	switch sex := line2[20]; sex {
+	case 'M', 'F':
+		p.Sex = Sex(sex)
+	case mrzFiller:
+	default:
+		return Passport{}, ErrInvalidMRZ
+	}
+
+	return p, nil
+}
+
+// GenerateMRZ формирует машиночитаемую зону паспорта так, как ее печатает МВД. Проверяется только
+// формат полей, поэтому перед генерацией данные стоит проверить Passport.Validate. Имена длиннее
+// поля машиночитаемой зоны обрезаются.
+func GenerateMRZ(p Passport) ([]string, error) {
+	if p.Series == "" {
+		return nil, ErrEmptyPassportSeries
+	}
+	if !passportSeriesRegexp.MatchString(p.Series) {
+		return nil, ErrInvalidPassportSeriesNot4Digits
+	}
+	if p.Number == "" {
+		return nil, ErrEmptyPassportNumber
+	}
+	if !passportNumberRegexp.MatchString(p.Number) {
+		return nil, ErrInvalidPassportNumber
+	}
+	if p.IssuerCode == "" {
+		return nil, ErrEmptyIssuedCode
+	}
+	if !issuedCodeRegexp.MatchString(p.IssuerCode) {
+		return nil, ErrInvalidIssuedCode
+	}
+	if p.IssueDate.IsZero() {
+		return nil, ErrEmptyIssueDate
+	}
+	if p.Birthday.IsZero() {
+		return nil, ErrEmptyBirthday
+	}
+
+	sex := byte(mrzFiller)
+	switch p.Sex {
+	case SexMale, SexFemale:
+		sex = p.Sex[0]
+	case "":
+	default:
+		return nil, ErrInvalidMRZ
+	}
+
+	names, err := mrzEncodeNames(p.LastName, p.FirstName, p.MiddleName)
+	if err != nil {
+		return nil, err
+	}
+	line1 := mrzPad("PNRUS"+names, mrzLineLength)
+
+	number := p.Series[:3] + p.Number
+	birthday := p.Birthday.Format("060102")
+	optional := mrzPad(p.Series[3:]+p.IssueDate.Format("060102")+strings.ReplaceAll(p.IssuerCode, "-", ""), 14)
+	expiration := strings.Repeat(string(mrzFiller), 7)
+
+	var b strings.Builder
+	b.WriteString(number)
+	b.WriteByte(mrzCheckDigit(number))
+	b.WriteString("RUS")
+	b.WriteString(birthday)
+	b.WriteByte(mrzCheckDigit(birthday))
+	b.WriteByte(sex)
+	b.WriteString(expiration)
+	b.WriteString(optional)
+	b.WriteByte(mrzCheckDigit(optional))
+	line2 := b.String()
+	line2 += string(mrzCheckDigit(line2[0:10] + line2[13:20] + line2[21:43]))
+
+	return []string{line1, line2}, nil
+}
+
+// mrzEncodeNames формирует поле имени "ФАМИЛИЯ<<ИМЯ<ОТЧЕСТВО"
+func mrzEncodeNames(lastName, firstName, middleName string) (string, error) {
+	if lastName == "" {
+		return "", ErrEmptyLastName
+	}
+
+	field, err := mrzEncodeName(lastName)
+	if err != nil {
+		return "", err
+	}
+	for i, name := range []string{firstName, middleName} {
+		if name == "" {
+			continue
+		}
+		encoded, err := mrzEncodeName(name)
+		if err != nil {
+			return "", err
+		}
+		separator := "<"
+		if i == 0 {
+			separator = "<<"
+		}
+		field += separator + encoded
+	}
+
+	if limit := mrzLineLength - len("PNRUS"); len(field) > limit {
+		field = field[:limit]
+	}
+	return field, nil
+}
+
+// mrzNamePunctuation как в МЧЗ записываются символы, которые допустимы в ФИО помимо кириллицы:
+// апостроф, точка и скобки опускаются, запятая становится разделителем, а римские цифры I и V
+// заменяются буквами И и В, которые транслитерируются в те же I и V
+var mrzNamePunctuation = strings.NewReplacer("'", "", ".", "", "(", "", ")", "", ",", " ", "I", "И", "V", "В")
+
+// mrzEncodeName транслитерирует имя по таблице МВД, части имени разделяются символом <
+func mrzEncodeName(name string) (string, error) {
+	latin, err := translit.ToLatin(mrzNamePunctuation.Replace(name), translit.MRZ)
+	if err != nil {
+		return "", ErrNonCyrillicCharacter
+	}
+	latin = strings.NewReplacer("-", "<", " ", "<").Replace(latin)
+	// Пустые части после пропущенных символов, например в "Иван (Джон)", не должны дать разделитель <<
+	latin = strings.Join(strings.FieldsFunc(latin, func(r rune) bool { return r == mrzFiller }), string(mrzFiller))
+	for i := 0; i < len(latin); i++ {
+		if _, ok := mrzCharValue(latin[i]); !ok {
+			return "", ErrNonCyrillicCharacter
+		}
+	}
+	return latin, nil
+}
+
+func mrzPad(value string, length int) string {
+	return value + strings.Repeat(string(mrzFiller), length-len(value))
+}
+
+// mrzCharValue значение символа для расчета контрольной цифры
+func mrzCharValue(c byte) (int, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0'), true
+	case c >= 'A' && c <= 'Z':
+		return int(c-'A') + 10, true
+	case c == mrzFiller:
+		return 0, true
+	}
+	return 0, false
+}
+
+// mrzCheckDigit контрольная цифра с весами 7, 3, 1
+func mrzCheckDigit(field string) byte {
+	weights := [3]int{7, 3, 1}
+	sum := 0
+	for i := 0; i < len(field); i++ {
+		value, _ := mrzCharValue(field[i])
+		sum += value * weights[i%3]
+	}
+	return byte('0' + sum%10)
+}
+
+// mrzCheckDigitMatches сверяет контрольную цифру. У незаполненного поля контрольная цифра тоже может быть незаполнена.
+func mrzCheckDigitMatches(field string, digit byte) bool {
+	if digit == mrzFiller {
+		return strings.Trim(field, string(mrzFiller)) == ""
+	}
+	return mrzCheckDigit(field) == digit
+}
+
+// mrzDecodeNames разбирает поле имени "ФАМИЛИЯ<<ИМЯ<ОТЧЕСТВО"
+func mrzDecodeNames(field string) (lastName, firstName, middleName string, err error) {
+	field = strings.TrimRight(field, string(mrzFiller))
+	surname, given, _ := strings.Cut(field, "<<")
+	if surname == "" {
+		return "", "", "", ErrInvalidMRZ
+	}
+
+	if lastName, err = mrzDecodeName(strings.ReplaceAll(surname, "<", "-")); err != nil {
+		return "", "", "", err
+	}
+
+	names := strings.FieldsFunc(given, func(r rune) bool { return r == mrzFiller })
+	if len(names) == 0 {
+		return lastName, "", "", nil
+	}
+	if firstName, err = mrzDecodeName(names[0]); err != nil {
+		return "", "", "", err
+	}
+	if middleName, err = mrzDecodeName(strings.Join(names[1:], " ")); err != nil {
+		return "", "", "", err
+	}
+	return lastName, firstName, middleName, nil
+}
+
+func mrzDecodeName(name string) (string, error) {
+	cyrillic, err := translit.ToCyrillic(name, translit.MRZ)
+	if err != nil {
+		return "", ErrInvalidMRZ
+	}
+
+	var b strings.Builder
+	// Заглавными в MRZ пишутся все буквы, в анкете — только первая буква каждой части
+	wordStart := true
+	for _, r := range cyrillic {
+		if !wordStart {
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+		wordStart = r == '-' || r == ' '
+	}
+	return b.String(), nil
+}
+
+// mrzDate разбирает дату YYMMDD, отсчитывая год от века century
+func mrzDate(value string, century int) (time.Time, error) {
+	date, err := time.Parse("060102", value)
+	if err != nil {
+		return time.Time{}, ErrInvalidMRZ
+	}
+	return time.Date(century+date.Year()%100, date.Month(), date.Day(), 0, 0, 0, 0, time.UTC), nil
+}
+
+// mrzBirthday выбирает век даты рождения: владельцу на дату выдачи должно быть не меньше 14 лет
+func mrzBirthday(value string, issueDate time.Time) (time.Time, error) {
+	birthday, err := mrzDate(value, 2000)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if birthday.AddDate(RuleSetAt(issueDate).MinIssueAge, 0, 0).After(issueDate) {
+		birthday = birthday.AddDate(-100, 0, 0)
+	}
+	return birthday, nil
+}
@@ -0,0 +1,257 @@
+package passport_validator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseMRZ(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		lines   []string
+		want    Passport
+		wantErr error
+	}{
+		"valid": {
+			lines: []string{
+				"PNRUSIVANOV<<IVAN<IVANOVI3<<<<<<<<<<<<<<<<<<",
+				"4611234562RUS9702204M<<<<<<<7170220500001<98",
+			},
+			want: Passport{
+				LastName:   "Иванов",
+				FirstName:  "Иван",
+				MiddleName: "Иванович",
+				Series:     "4617",
+				Number:     "123456",
+				IssueDate:  time.Date(2017, 2, 20, 0, 0, 0, 0, time.UTC),
+				Birthday:   time.Date(1997, 2, 20, 0, 0, 0, 0, time.UTC),
+				IssuerCode: "500-001",
+				Sex:        SexMale,
+			},
+		},
+		"double surname and special letters": {
+			lines: []string{
+				"PNRUSWEPKINA<2LKINA<<8NA<SERGEEVNA<<<<<<<<<<",
+				"4520000016RUS0003159F<<<<<<<7140320770001<24",
+			},
+			want: Passport{
+				LastName:   "Щепкина-Ёлкина",
+				FirstName:  "Яна",
+				MiddleName: "Сергеевна",
+				Series:     "4527",
+				Number:     "000001",
+				IssueDate:  time.Date(2014, 3, 20, 0, 0, 0, 0, time.UTC),
+				Birthday:   time.Date(2000, 3, 15, 0, 0, 0, 0, time.UTC),
+				IssuerCode: "770-001",
+				Sex:        SexFemale,
+			},
+		},
+		"surrounding spaces": {
+			lines: []string{
+				" PNRUSIVANOV<<IVAN<IVANOVI3<<<<<<<<<<<<<<<<<<\n",
+				"4611234562RUS9702204M<<<<<<<7170220500001<98 ",
+			},
+			want: validMRZPassport(),
+		},
+		"one line": {
+			lines:   []string{"PNRUSIVANOV<<IVAN<IVANOVI3<<<<<<<<<<<<<<<<<<"},
+			wantErr: ErrInvalidMRZ,
+		},
+		"foreign passport": {
+			lines: []string{
+				"P<RUSIVANOV<<IVAN<<<<<<<<<<<<<<<<<<<<<<<<<<<",
+				"4611234562RUS9702204M<<<<<<<7170220500001<98",
+			},
+			wantErr: ErrInvalidMRZ,
+		},
+		"lowercase": {
+			lines: []string{
+				"PNRUSivanov<<IVAN<IVANOVI3<<<<<<<<<<<<<<<<<<",
+				"4611234562RUS9702204M<<<<<<<7170220500001<98",
+			},
+			wantErr: ErrInvalidMRZ,
+		},
+		"number check digit": {
+			lines: []string{
+				"PNRUSIVANOV<<IVAN<IVANOVI3<<<<<<<<<<<<<<<<<<",
+				"4611234563RUS9702204M<<<<<<<7170220500001<98",
+			},
+			wantErr: ErrInvalidMRZCheckDigit,
+		},
+		"birthday check digit": {
+			lines: []string{
+				"PNRUSIVANOV<<IVAN<IVANOVI3<<<<<<<<<<<<<<<<<<",
+				"4611234562RUS9702214M<<<<<<<7170220500001<98",
+			},
+			wantErr: ErrInvalidMRZCheckDigit,
+		},
+		"optional data check digit": {
+			lines: []string{
+				"PNRUSIVANOV<<IVAN<IVANOVI3<<<<<<<<<<<<<<<<<<",
+				"4611234562RUS9702204M<<<<<<<7170220500002<98",
+			},
+			wantErr: ErrInvalidMRZCheckDigit,
+		},
+		"composite check digit": {
+			lines: []string{
+				"PNRUSIVANOV<<IVAN<IVANOVI3<<<<<<<<<<<<<<<<<<",
+				"4611234562RUS9702204M<<<<<<<7170220500001<97",
+			},
+			wantErr: ErrInvalidMRZCheckDigit,
+		},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := ParseMRZ(tt.lines)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, p)
+		})
+	}
+}
+
+func validMRZPassport() Passport {
+	p := validPassport()
+	p.IssuedBy = ""
+	p.PlaceOfBirth = ""
+	p.Sex = SexMale
+	return p
+}
+
+func Test_ParseMRZValidate(t *testing.T) {
+	t.Parallel()
+
+	p, err := ParseMRZ([]string{
+		"PNRUSIVANOV<<IVAN<IVANOVI3<<<<<<<<<<<<<<<<<<",
+		"4611234562RUS9702204M<<<<<<<7170220500001<98",
+	})
+	require.NoError(t, err)
+	assert.NoError(t, p.Validate(time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC)))
+}
+
+func Test_GenerateMRZ(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		modify  func(p *Passport)
+		want    []string
+		wantErr error
+	}{
+		"valid": {
+			modify: func(p *Passport) {},
+			want: []string{
+				"PNRUSIVANOV<<IVAN<IVANOVI3<<<<<<<<<<<<<<<<<<",
+				"4611234562RUS9702204M<<<<<<<7170220500001<98",
+			},
+		},
+		"issuer code without dash": {
+			modify: func(p *Passport) { p.IssuerCode = "500001" },
+			want: []string{
+				"PNRUSIVANOV<<IVAN<IVANOVI3<<<<<<<<<<<<<<<<<<",
+				"4611234562RUS9702204M<<<<<<<7170220500001<98",
+			},
+		},
+		"double surname without middle name": {
+			modify: func(p *Passport) {
+				p.LastName = "Щепкина-Ёлкина"
+				p.FirstName = "Яна"
+				p.MiddleName = ""
+			},
+			want: []string{
+				"PNRUSWEPKINA<2LKINA<<8NA<<<<<<<<<<<<<<<<<<<<",
+				"4611234562RUS9702204M<<<<<<<7170220500001<98",
+			},
+		},
+		"long name is truncated": {
+			modify: func(p *Passport) {
+				p.LastName = "Константинопольская-Благовещенская"
+				p.FirstName = "Александра"
+			},
+			want: []string{
+				"PNRUSKONSTANTINOPOL9SKA8<BLAGOVEWENSKA8<<ALE",
+				"4611234562RUS9702204M<<<<<<<7170220500001<98",
+			},
+		},
+		"apostrophe and roman numeral": {
+			modify: func(p *Passport) {
+				p.LastName = "Д'Артаньян"
+				p.FirstName = "Шарль"
+				p.MiddleName = "(Луи) IV"
+			},
+			want: []string{
+				"PNRUSDARTAN98N<<4ARL9<LUI<IV<<<<<<<<<<<<<<<<",
+				"4611234562RUS9702204M<<<<<<<7170220500001<98",
+			},
+		},
+		"latin name": {
+			modify:  func(p *Passport) { p.FirstName = "Ivan" },
+			wantErr: ErrNonCyrillicCharacter,
+		},
+		"invalid series": {
+			modify:  func(p *Passport) { p.Series = "461" },
+			wantErr: ErrInvalidPassportSeriesNot4Digits,
+		},
+		"empty issue date": {
+			modify:  func(p *Passport) { p.IssueDate = time.Time{} },
+			wantErr: ErrEmptyIssueDate,
+		},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			p := validMRZPassport()
+			tt.modify(&p)
+
+			lines, err := GenerateMRZ(p)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, lines)
+
+			parsed, err := ParseMRZ(lines)
+			require.NoError(t, err)
+			assert.Equal(t, p.Series, parsed.Series)
+			assert.Equal(t, p.Number, parsed.Number)
+		})
+	}
+}
+
+func Test_MRZNamesRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, names := range [][3]string{
+		{"Щепкина-Ёлкина", "Яна", "Сергеевна"},
+		{"Подъячев", "Эльдар", ""},
+		{"Шестаков-Щербаков", "Юрий", "Ильич"},
+	} {
+		field, err := mrzEncodeNames(names[0], names[1], names[2])
+		require.NoError(t, err)
+
+		lastName, firstName, middleName, err := mrzDecodeNames(field)
+		require.NoError(t, err)
+		assert.Equal(t, names, [3]string{lastName, firstName, middleName})
+		assert.NoError(t, IsPassportLastNameValid(lastName))
+		assert.NoError(t, IsPassportFirstNameValid(firstName))
+		assert.NoError(t, IsPassportMiddleNameValid(middleName))
+	}
+}
@@ -0,0 +1,98 @@
+package passport_validator
+
+import (
+	"strconv"
+	"time"
+)
+
+// Passport данные паспорта гражданина РФ в том виде, в котором они вносятся в анкету.
+type Passport struct {
+	LastName     string
+	FirstName    string
+	MiddleName   string
+	Series       string
+	Number       string
+	IssueDate    time.Time
+	Birthday     time.Time
+	IssuerCode   string
+	IssuedBy     string
+	PlaceOfBirth string
+	Sex          Sex
+}
+
+// Sex пол владельца так, как он записан в машиночитаемой зоне
+type Sex string
+
+const (
+	SexMale   Sex = "M"
+	SexFemale Sex = "F"
+)
+
+// Validate проверяет все поля паспорта и связи между ними на дату checkDate.
+// В отличие от отдельных IsPassport*Valid функций возвращает не первую ошибку, а все найденные сразу
+// в виде ValidationErrors. Предупреждения (SeverityWarning) в ошибку не попадают, их возвращает Check.
+func (p Passport) Validate(checkDate time.Time) error {
+	return p.Check(checkDate).Err()
+}
+
+// Check как Validate, но дополнительно возвращает версию набора правил, по которому проверен паспорт
+func (p Passport) Check(checkDate time.Time) ValidationResult {
+	return defaultValidator.checkPassport(p, checkDate)
+}
+
+func (v *Validator) checkPassport(p Passport, checkDate time.Time) ValidationResult {
+	var errs fieldErrors
+
+	errs.check(FieldLastName, p.LastName, v.IsPassportLastNameValid(p.LastName))
+	errs.check(FieldFirstName, p.FirstName, v.IsPassportFirstNameValid(p.FirstName))
+	errs.check(FieldMiddleName, p.MiddleName, v.IsPassportMiddleNameValid(p.MiddleName))
+	seriesErr := v.seriesValid(p.Series, checkDate)
+	errs.check(FieldSeries, p.Series, seriesErr)
+	errs.check(FieldNumber, p.Number, IsPassportNumberValid(p.Number))
+	errs.check(FieldBirthday, formatDate(p.Birthday), v.birthdayValid(p.Birthday, checkDate))
+	// Дата выдачи проверяется относительно даты рождения, поэтому это уже межполевая проверка
+	if err := v.issueDateValid(p.IssueDate, p.Birthday, checkDate); err != nil {
+		validationErr := newValidationError(FieldIssueDate, formatDate(p.IssueDate), err)
+		expiration, _ := v.expirationInfo(p.IssueDate, p.Birthday, checkDate)
+		if expiration.Status == ExpirationExpired {
+			validationErr.Params = map[string]string{
+				ParamExpirationDate: formatDate(expiration.GraceEnd),
+				ParamReplacementAge: strconv.Itoa(expiration.ReplacementAge),
+			}
+		}
+		errs = append(errs, validationErr)
+	}
+	// Год бланка в серии сверяем с датой выдачи, только если оба поля корректны сами по себе
+	if seriesErr == nil && !p.IssueDate.IsZero() {
+		errs.check(FieldSeries, p.Series, v.seriesMatchIssueDate(p.Series, p.IssueDate))
+	}
+	issuerCodeErr := IsPassportIssuerCodeStrictValid(p.IssuerCode)
+	errs.check(FieldIssuerCode, p.IssuerCode, issuerCodeErr)
+	// Наличие кода в справочнике проверяется, только если справочник задан через WithIssuerDirectory
+	if issuerCodeErr == nil && v.issuerDirectory != nil {
+		errs.check(FieldIssuerCode, p.IssuerCode, v.IsPassportIssuerCodeKnown(p.IssuerCode, p.IssueDate))
+	}
+	// Паспорт могут выдать не по месту жительства, поэтому расхождение регионов только предупреждение
+	if seriesErr == nil && issuerCodeErr == nil {
+		errs.warn(FieldIssuerCode, p.IssuerCode, v.IsPassportRegionConsistent(p.Series, p.IssuerCode))
+	}
+
+	// Правила выбираются по дате выдачи, если ее нет — по дате проверки
+	ruleSetDate := p.IssueDate
+	if ruleSetDate.IsZero() {
+		ruleSetDate = checkDate
+	}
+
+	return ValidationResult{
+		CheckDate: checkDate,
+		RuleSet:   v.RuleSetAt(ruleSetDate).Version,
+		Errors:    ValidationErrors(errs),
+	}
+}
+
+func formatDate(date time.Time) string {
+	if date.IsZero() {
+		return ""
+	}
+	return date.Format("2006-01-02")
+}
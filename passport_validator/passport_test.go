@@ -0,0 +1,115 @@
+package passport_validator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validPassport() Passport {
+	return Passport{
+		LastName:     "Иванов",
+		FirstName:    "Иван",
+		MiddleName:   "Иванович",
+		Series:       "4617",
+		Number:       "123456",
+		IssueDate:    time.Date(2017, 2, 20, 0, 0, 0, 0, time.UTC),
+		Birthday:     time.Date(1997, 2, 20, 0, 0, 0, 0, time.UTC),
+		IssuerCode:   "500-001",
+		IssuedBy:     "ОУФМС России по Московской обл.",
+		PlaceOfBirth: "г. Москва",
+	}
+}
+
+func Test_PassportValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		modify    func(p *Passport)
+		checkDate time.Time
+		wantErrs  []error
+	}{
+		"valid passport": {
+			modify:    func(p *Passport) {},
+			checkDate: time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC),
+		},
+		"valid passport without middle name": {
+			modify:    func(p *Passport) { p.MiddleName = "" },
+			checkDate: time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC),
+		},
+		"single invalid field": {
+			modify:    func(p *Passport) { p.Number = "12345" },
+			checkDate: time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC),
+			wantErrs:  []error{ErrInvalidPassportNumber},
+		},
+		"all failures returned at once": {
+			modify: func(p *Passport) {
+				p.LastName = ""
+				p.FirstName = "Elon"
+				p.Series = "4696"
+				p.IssuerCode = "50-001"
+			},
+			checkDate: time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC),
+			wantErrs: []error{
+				ErrEmptyLastName,
+				ErrNonCyrillicCharacter,
+				ErrInvalidPassportSeries,
+				ErrInvalidIssuedCode,
+			},
+		},
+		"cross-field issue date before 14 birthday": {
+			modify: func(p *Passport) {
+				p.IssueDate = time.Date(2005, 2, 20, 0, 0, 0, 0, time.UTC)
+			},
+			checkDate: time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC),
+			wantErrs:  []error{ErrInvalidIssueDateBefore14Birthday},
+		},
+		"passport expired at 45": {
+			modify: func(p *Passport) {
+				p.Birthday = time.Date(1955, 01, 01, 0, 0, 0, 0, time.UTC)
+				p.IssueDate = time.Date(1999, 2, 20, 0, 0, 0, 0, time.UTC)
+			},
+			checkDate: time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC),
+			wantErrs:  []error{ErrIssueDatePassportExpiredAt45},
+		},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			p := validPassport()
+			tt.modify(&p)
+
+			err := p.Validate(tt.checkDate)
+			if len(tt.wantErrs) != 0 {
+				require.Error(t, err)
+				for _, wantErr := range tt.wantErrs {
+					assert.ErrorIs(t, err, wantErr)
+				}
+
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_PassportValidateSeriesIssueDateMismatch(t *testing.T) {
+	t.Parallel()
+
+	p := validPassport()
+	p.Series = "4620"
+
+	err := p.Validate(time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC))
+	require.Error(t, err)
+
+	var errs ValidationErrors
+	require.ErrorAs(t, err, &errs)
+	require.Len(t, errs, 1)
+	assert.Equal(t, FieldSeries, errs[0].Field)
+	assert.ErrorIs(t, errs[0], ErrSeriesYearAfterIssueDate)
+}
@@ -36,8 +36,36 @@ var (
 	ErrIssueDatePassportInFuture        = errors.New("passport issued in future")
 	ErrIssueDatePassportExpiredAt20     = errors.New("passport expired at 20")
 	ErrIssueDatePassportExpiredAt45     = errors.New("passport expired at 45")
+	ErrIssueDatePassportExpired         = errors.New("passport expired at replacement age")
+	ErrSeriesYearAfterIssueDate         = errors.New("blank year in passport series is after issue year")
+	ErrSeriesYearTooOld                 = errors.New("blank year in passport series is too old for issue date")
+	ErrInvalidPassportSeriesRegion      = errors.New("region code in passport series does not exist")
+	ErrUnknownIssuedCode                = errors.New("issued code not found in directory")
+	ErrIssuerDirectoryNotLoaded         = errors.New("issuer directory is not loaded")
+	ErrIssuedCodeNotActive              = errors.New("issued code was not active on issue date")
+	ErrPassportRegionMismatch           = errors.New("passport series region does not match issued code region")
+	ErrInvalidIssuedCodeLevel           = errors.New("third digit of issued code is not a valid division level")
+	ErrPassportListedInvalid            = errors.New("passport is in the MVD list of invalid passports")
+	ErrInvalidMRZ                       = errors.New("MRZ is not two 44-character lines of a Russian internal passport")
+	ErrInvalidMRZCheckDigit             = errors.New("MRZ check digit mismatch")
+	ErrIssueDateBeforeBirthday          = errors.New("issue date is before birthday")
 )
 
+// PassportExpiredAtAgeError паспорт подлежал замене в возрасте Age. Возвращается для возрастов
+// замены из RuleSet.ReplacementAges, кроме 20 и 45: для них остаются ErrIssueDatePassportExpiredAt20
+// и ErrIssueDatePassportExpiredAt45. Оборачивает ErrIssueDatePassportExpired.
+type PassportExpiredAtAgeError struct {
+	Age int
+}
+
+func (e *PassportExpiredAtAgeError) Error() string {
+	return "passport expired at " + strconv.Itoa(e.Age)
+}
+
+func (e *PassportExpiredAtAgeError) Unwrap() error {
+	return ErrIssueDatePassportExpired
+}
+
 const (
 	Age20PassportChange  = 20
 	Age45PassportChange  = 45
@@ -45,38 +73,39 @@ const (
 )
 
 func IsPassportLastNameValid(lastName string) error {
+	return defaultValidator.IsPassportLastNameValid(lastName)
+}
+
+func (v *Validator) IsPassportLastNameValid(lastName string) error {
 	if lastName == "" {
 		return ErrEmptyLastName
 	}
-	return nameValidator(lastName)
+	return v.nameValidator(lastName)
 }
 
 func IsPassportFirstNameValid(firstName string) error {
+	return defaultValidator.IsPassportFirstNameValid(firstName)
+}
+
+func (v *Validator) IsPassportFirstNameValid(firstName string) error {
 	if firstName == "" {
 		return ErrEmptyFirstName
 	}
-	return nameValidator(firstName)
+	return v.nameValidator(firstName)
 }
 
 func IsPassportMiddleNameValid(middleName string) error {
+	return defaultValidator.IsPassportMiddleNameValid(middleName)
+}
+
+func (v *Validator) IsPassportMiddleNameValid(middleName string) error {
 	// Не проверяем на пустоту, так как отчества может не быть
-	return nameValidator(middleName)
+	return v.nameValidator(middleName)
 }
 
-func nameValidator(s string) error {
-	allowedCharacters := map[rune]bool{
-		'-':  true,
-		' ':  true,
-		'.':  true,
-		',':  true,
-		'I':  true,
-		'V':  true,
-		'\'': true,
-		'(':  true,
-		')':  true,
-	}
+func (v *Validator) nameValidator(s string) error {
 	for _, c := range s {
-		if !unicode.Is(unicode.Cyrillic, c) && !allowedCharacters[c] {
+		if !unicode.Is(unicode.Cyrillic, c) && !v.allowedNameCharacters[c] {
 			return ErrNonCyrillicCharacter
 		}
 	}
@@ -84,19 +113,27 @@ func nameValidator(s string) error {
 }
 
 func IsPassportSeriesValid(series string, checkDate time.Time) error {
+	return defaultValidator.seriesValid(series, checkDate)
+}
+
+func (v *Validator) seriesValid(series string, checkDate time.Time) error {
 	if series == "" {
 		return ErrEmptyPassportSeries
 	}
 
 	// Примитивная проверка: 4 цифры серии паспорта РФ
 	if !passportSeriesRegexp.MatchString(series) {
+		// Серия паспорта СССР образца 1974 года: сообщаем, почему документ не принимается
+		if formatValid, numeralValid := romanSeriesValid(series); formatValid && numeralValid {
+			return ErrSovietPassportSeries
+		}
 		return ErrInvalidPassportSeriesNot4Digits
 	}
 
 	// Проверяем, что паспорт выдан после 1997 года, но не больше чем текущий год +5 лет.
 	// Современный бланк появился в 1997 году. Две последних цифры паспорта должны быть в диапазоне 97-99/00-time.Now().Year()%100+5
 	// Иногда квота на паспорта заканчивается поэтому печатают в счет будуших квот
-	blankReleaseDate := time.Date(1997, time.January, 01, 00, 0, 0, 0, time.UTC)
+	blankReleaseYear := v.RuleSetAt(checkDate).BlankReleaseYear
 	nowYear := checkDate.Year()
 
 	issueYear := series[len(series)-2:]
@@ -105,71 +142,112 @@ func IsPassportSeriesValid(series string, checkDate time.Time) error {
 		return ErrInvalidPassportSeriesNot4Digits
 	}
 
-	if issueYearInt <= nowYear%100+5 {
+	if issueYearInt <= nowYear%100+v.seriesQuotaYears {
 		issueYearInt = issueYearInt + 2000
 	} else {
 		issueYearInt = issueYearInt + 1900
 	}
 
-	if issueYearInt < blankReleaseDate.Year() || issueYearInt > nowYear+5 {
+	if issueYearInt < blankReleaseYear || issueYearInt > nowYear+v.seriesQuotaYears {
 		return ErrInvalidPassportSeries
 	}
 
+	// Первые две цифры серии — код субъекта РФ по ОКАТО, субъект должен существовать в год выпуска бланка
+	if !seriesRegionExists(series, issueYearInt) {
+		return ErrInvalidPassportSeriesRegion
+	}
+
 	return nil
 }
 
-func IsPassportNumberValid(number string) error {
-	if number == "" {
-		return ErrEmptyPassportNumber
+// seriesBlankYear год выпуска бланка по двум последним цифрам серии. Бланк не может быть старше
+// blankReleaseYear, поэтому "97"-"99" это 1997-1999, а "00"-"96" это 2000-2096.
+func seriesBlankYear(series string, blankReleaseYear int) (int, error) {
+	yearDigits, err := strconv.Atoi(series[len(series)-2:])
+	if err != nil {
+		return 0, ErrInvalidPassportSeriesNot4Digits
 	}
 
-	// Примитивная проверка: 6 цифр серии паспорта РФ
-	if !passportNumberRegexp.MatchString(number) {
-		return ErrInvalidPassportNumber
+	year := blankReleaseYear - blankReleaseYear%100 + yearDigits
+	if year < blankReleaseYear {
+		year += 100
 	}
+	return year, nil
+}
 
-	return nil
+// IsPassportSeriesMatchIssueDate проверяет, что год бланка в серии согласуется с датой выдачи:
+// бланк не может быть напечатан позже года выдачи (с допуском на печать в счет будущих квот)
+// и не может пролежать до выдачи слишком долго.
+func IsPassportSeriesMatchIssueDate(series string, issueDate time.Time) error {
+	return defaultValidator.seriesMatchIssueDate(series, issueDate)
 }
 
-func IsPassportIssueDateValid(issueDate, birthday time.Time, checkDate time.Time) error {
+func (v *Validator) IsPassportSeriesMatchIssueDate(series string, issueDate time.Time) error {
+	return v.seriesMatchIssueDate(series, issueDate)
+}
+
+func (v *Validator) seriesMatchIssueDate(series string, issueDate time.Time) error {
+	if !passportSeriesRegexp.MatchString(series) {
+		return ErrInvalidPassportSeriesNot4Digits
+	}
 	if issueDate.IsZero() {
 		return ErrEmptyIssueDate
 	}
 
-	if birthday.IsZero() {
-		return ErrEmptyBirthday
+	issueYear := issueDate.Year()
+	blankYear, err := seriesBlankYear(series, v.RuleSetAt(issueDate).BlankReleaseYear)
+	if err != nil {
+		return err
 	}
 
-	ageAtIssue := issueDate.Year() - birthday.Year()
-	// Проверка на возможность выдачи паспорта до 14 лет
-	if ageAtIssue < 14 {
-		return ErrInvalidIssueDateBefore14Birthday
+	if blankYear > issueYear+v.seriesCarryOverYears {
+		return ErrSeriesYearAfterIssueDate
 	}
-
-	// Проверка выдачи паспорта в будущем
-	if issueDate.After(checkDate) {
-		return ErrIssueDatePassportInFuture
+	if blankYear < issueYear-v.seriesMaxBlankAge {
+		return ErrSeriesYearTooOld
 	}
 
-	// Проверка выдачи паспорта до 20 лет
-	if ageAtIssue < 20 {
-		expirationDate := birthday.AddDate(Age20PassportChange, 0, PassportDaysValidity)
-		if checkDate.After(expirationDate) {
-			return ErrIssueDatePassportExpiredAt20
-		}
+	return nil
+}
+
+func IsPassportNumberValid(number string) error {
+	if number == "" {
+		return ErrEmptyPassportNumber
 	}
 
-	// Проверка выдачи паспорта до 45 лет
-	if ageAtIssue < 45 {
-		expirationDate := birthday.AddDate(Age45PassportChange, 0, PassportDaysValidity)
-		if checkDate.After(expirationDate) {
-			return ErrIssueDatePassportExpiredAt45
-		}
+	// Примитивная проверка: 6 цифр серии паспорта РФ
+	if !passportNumberRegexp.MatchString(number) {
+		return ErrInvalidPassportNumber
 	}
 
 	return nil
 }
 
+func IsPassportIssueDateValid(issueDate, birthday time.Time, checkDate time.Time) error {
+	return defaultValidator.issueDateValid(issueDate, birthday, checkDate)
+}
+
+func (v *Validator) issueDateValid(issueDate, birthday time.Time, checkDate time.Time) error {
+	expiration, err := v.expirationInfo(issueDate, birthday, checkDate)
+	if err != nil {
+		return err
+	}
+
+	if expiration.Status != ExpirationExpired {
+		return nil
+	}
+	// Паспорт, выданный до 20 лет, должен быть заменен в 20, выданный до 45 лет — в 45.
+	// Другие возраста замены могут появиться в новых наборах правил, см. RuleSet.ReplacementAges.
+	switch expiration.ReplacementAge {
+	case Age20PassportChange:
+		return ErrIssueDatePassportExpiredAt20
+	case Age45PassportChange:
+		return ErrIssueDatePassportExpiredAt45
+	default:
+		return &PassportExpiredAtAgeError{Age: expiration.ReplacementAge}
+	}
+}
+
 func IsPassportIssuerCodeValid(issuedCode string) error {
 	if issuedCode == "" {
 		return ErrEmptyIssuedCode
@@ -184,14 +262,18 @@ func IsPassportIssuerCodeValid(issuedCode string) error {
 }
 
 func IsPassportBirthdayValid(birthday time.Time, checkDate time.Time) error {
+	return defaultValidator.birthdayValid(birthday, checkDate)
+}
+
+func (v *Validator) birthdayValid(birthday time.Time, checkDate time.Time) error {
 	if birthday.IsZero() {
 		return ErrInvalidBirthday
 	}
 	if birthday.After(checkDate) {
 		return ErrInvalidBirthday
 	}
-	// Если меньше 18 лет
-	if birthday.After(checkDate.AddDate(-18, 0, 0)) {
+	// Если меньше минимального возраста, по умолчанию 18 лет
+	if AgeAt(birthday, checkDate, v.leapDayPolicy).Years < v.minAge {
 		return ErrInvalidBirthday
 	}
 
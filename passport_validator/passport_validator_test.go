@@ -272,6 +272,25 @@ func Test_PassportSeries(t *testing.T) {
 			checkDate: time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC),
 			wantErr:   ErrInvalidPassportSeriesNot4Digits,
 		},
+		"series with region 00": {
+			series:    "0017",
+			checkDate: time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC),
+			wantErr:   ErrInvalidPassportSeriesRegion,
+		},
+		"series with non-existent region": {
+			series:    "0217",
+			checkDate: time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC),
+			wantErr:   ErrInvalidPassportSeriesRegion,
+		},
+		"crimea series after 2014": {
+			series:    "3515",
+			checkDate: time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC),
+		},
+		"crimea series before 2014": {
+			series:    "3510",
+			checkDate: time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC),
+			wantErr:   ErrInvalidPassportSeriesRegion,
+		},
 	}
 
 	for name, tt := range testCases {
@@ -292,6 +311,74 @@ func Test_PassportSeries(t *testing.T) {
 	}
 }
 
+func Test_PassportSeriesMatchIssueDate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		series    string
+		issueDate time.Time
+		wantErr   error
+	}{
+		"same year": {
+			series:    "4617",
+			issueDate: time.Date(2017, 2, 20, 0, 0, 0, 0, time.UTC),
+		},
+		"blank printed year before issue": {
+			series:    "4616",
+			issueDate: time.Date(2017, 2, 20, 0, 0, 0, 0, time.UTC),
+		},
+		"blank printed in 1999 issued in 2000": {
+			series:    "4699",
+			issueDate: time.Date(2000, 2, 20, 0, 0, 0, 0, time.UTC),
+		},
+		"quota carry-over": {
+			series:    "4618",
+			issueDate: time.Date(2017, 12, 20, 0, 0, 0, 0, time.UTC),
+		},
+		"blank year after issue year": {
+			series:    "4619",
+			issueDate: time.Date(2017, 2, 20, 0, 0, 0, 0, time.UTC),
+			wantErr:   ErrSeriesYearAfterIssueDate,
+		},
+		"blank printed in 2017 issued in 1999": {
+			series:    "4617",
+			issueDate: time.Date(1999, 2, 20, 0, 0, 0, 0, time.UTC),
+			wantErr:   ErrSeriesYearAfterIssueDate,
+		},
+		"blank too old": {
+			series:    "4605",
+			issueDate: time.Date(2017, 2, 20, 0, 0, 0, 0, time.UTC),
+			wantErr:   ErrSeriesYearTooOld,
+		},
+		"invalid series": {
+			series:    "461",
+			issueDate: time.Date(2017, 2, 20, 0, 0, 0, 0, time.UTC),
+			wantErr:   ErrInvalidPassportSeriesNot4Digits,
+		},
+		"empty issue date": {
+			series:  "4617",
+			wantErr: ErrEmptyIssueDate,
+		},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := IsPassportSeriesMatchIssueDate(tt.series, tt.issueDate)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+			require.NoError(t, err)
+
+		})
+	}
+}
+
 func Test_PassportNumber(t *testing.T) {
 	t.Parallel()
 
@@ -496,6 +583,18 @@ func Test_PassportIssueDate(t *testing.T) {
 			checkDate: time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC),
 			wantErr:   ErrInvalidIssueDateBefore14Birthday,
 		},
+		"invalid issue date one day before 14 birthday": {
+			issueDate: time.Date(2024, 2, 19, 0, 0, 0, 0, time.UTC),
+			birthday:  time.Date(2010, 2, 20, 0, 0, 0, 0, time.UTC),
+			checkDate: time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC),
+			wantErr:   ErrInvalidIssueDateBefore14Birthday,
+		},
+		"invalid issue date issued at 19 in the year of 20 birthday": {
+			issueDate: time.Date(2010, 5, 1, 0, 0, 0, 0, time.UTC),
+			birthday:  time.Date(1990, 6, 1, 0, 0, 0, 0, time.UTC),
+			checkDate: time.Date(2011, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantErr:   ErrIssueDatePassportExpiredAt20,
+		},
 		"invalid issue date nil issueDate": {
 			issueDate: time.Date(0001, 01, 01, 0, 0, 0, 0, time.UTC),
 			birthday:  time.Date(2010, 2, 20, 0, 0, 0, 0, time.UTC),
@@ -571,6 +670,15 @@ func Test_PassportBirthday(t *testing.T) {
 			birthday:  time.Date(2006, 02, 26, 0, 0, 0, 0, time.UTC),
 			checkDate: time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC),
 		},
+		"18 year born on 29 february": {
+			birthday:  time.Date(2004, 02, 29, 0, 0, 0, 0, time.UTC),
+			checkDate: time.Date(2022, 02, 28, 0, 0, 0, 0, time.UTC),
+		},
+		"17 year born on 29 february": {
+			birthday:  time.Date(2004, 02, 29, 0, 0, 0, 0, time.UTC),
+			checkDate: time.Date(2022, 02, 27, 0, 0, 0, 0, time.UTC),
+			wantErr:   ErrInvalidBirthday,
+		},
 	}
 
 	for name, tt := range testCases {
@@ -0,0 +1,173 @@
+package passport_validator
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// regionsCSV коды субъектов РФ по ОКАТО, которыми начинается серия паспорта, и номера субъектов
+// по Конституции, которыми начинается код подразделения. Если субъект переименовывался или появился
+// позже 1997 года, у записи заполнены valid_from/valid_to.
+//
+//go:embed regions.csv
+var regionsCSV string
+
+// Region субъект РФ, закодированный в первых двух цифрах серии паспорта
+type Region struct {
+	// Code код субъекта по ОКАТО
+	Code string
+	// Subject номер субъекта по Конституции РФ, им начинается код подразделения
+	Subject string
+	Name    string
+	// ValidFrom дата, с которой действует запись, нулевая если с начала выдачи паспортов
+	ValidFrom time.Time
+	// ValidTo дата, с которой запись больше не действует, нулевая если действует сейчас
+	ValidTo time.Time
+}
+
+// activeInYear true, если запись действовала хотя бы часть года year
+func (r Region) activeInYear(year int) bool {
+	if !r.ValidFrom.IsZero() && r.ValidFrom.Year() > year {
+		return false
+	}
+	if !r.ValidTo.IsZero() && !r.ValidTo.After(time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)) {
+		return false
+	}
+	return true
+}
+
+// okatoRegions записи по коду ОКАТО в порядке действия
+var okatoRegions = mustParseRegions(regionsCSV)
+
+func mustParseRegions(data string) map[string][]Region {
+	regions, err := parseRegions(strings.NewReader(data))
+	if err != nil {
+		panic(err)
+	}
+	return regions
+}
+
+func parseRegions(r io.Reader) (map[string][]Region, error) {
+	reader := csv.NewReader(r)
+	reader.Comma = ';'
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	regions := make(map[string][]Region, len(records))
+	// Первая строка — заголовок
+	for _, record := range records[1:] {
+		validFrom, err := parseOptionalDate(record[3])
+		if err != nil {
+			return nil, fmt.Errorf("region %s: %w", record[0], err)
+		}
+		validTo, err := parseOptionalDate(record[4])
+		if err != nil {
+			return nil, fmt.Errorf("region %s: %w", record[0], err)
+		}
+
+		regions[record[0]] = append(regions[record[0]], Region{
+			Code:      record[0],
+			Subject:   record[1],
+			Name:      record[2],
+			ValidFrom: validFrom,
+			ValidTo:   validTo,
+		})
+	}
+	return regions, nil
+}
+
+func parseOptionalDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse("2006-01-02", value)
+}
+
+// SeriesRegion субъект РФ по первым двум цифрам серии паспорта с актуальным названием
+func SeriesRegion(series string) (Region, error) {
+	if series == "" {
+		return Region{}, ErrEmptyPassportSeries
+	}
+	if !passportSeriesRegexp.MatchString(series) {
+		return Region{}, ErrInvalidPassportSeriesNot4Digits
+	}
+
+	regions, ok := okatoRegions[series[:2]]
+	if !ok {
+		return Region{}, ErrInvalidPassportSeriesRegion
+	}
+	return regions[len(regions)-1], nil
+}
+
+// seriesRegionExists проверяет, что субъект из серии существовал в год выпуска бланка
+func seriesRegionExists(series string, blankYear int) bool {
+	for _, region := range okatoRegions[series[:2]] {
+		if region.activeInYear(blankYear) {
+			return true
+		}
+	}
+	return false
+}
+
+// RegionException допустимая пара субъекта из серии и субъекта из кода подразделения
+type RegionException struct {
+	// SeriesSubject номер субъекта, к которому относится код ОКАТО из серии
+	SeriesSubject string
+	// IssuerSubject номер субъекта из первых двух цифр кода подразделения
+	IssuerSubject string
+}
+
+// DefaultRegionExceptions автономные округа, у которых нет собственного кода ОКАТО в серии:
+// паспорта печатаются с кодом области или края, в состав которых входит округ, а выдаются
+// подразделениями с номером округа
+func DefaultRegionExceptions() []RegionException {
+	return []RegionException{
+		{SeriesSubject: "29", IssuerSubject: "83"}, // Ненецкий АО
+		{SeriesSubject: "72", IssuerSubject: "86"}, // Ханты-Мансийский АО — Югра
+		{SeriesSubject: "72", IssuerSubject: "89"}, // Ямало-Ненецкий АО
+		{SeriesSubject: "24", IssuerSubject: "84"}, // Таймырский АО
+		{SeriesSubject: "24", IssuerSubject: "88"}, // Эвенкийский АО
+		{SeriesSubject: "38", IssuerSubject: "85"}, // Усть-Ордынский Бурятский АО
+		{SeriesSubject: "75", IssuerSubject: "80"}, // Агинский Бурятский АО
+		{SeriesSubject: "59", IssuerSubject: "81"}, // Коми-Пермяцкий АО
+		{SeriesSubject: "41", IssuerSubject: "82"}, // Корякский АО
+	}
+}
+
+// IsPassportRegionConsistent сверяет субъект из серии паспорта с субъектом из кода подразделения.
+// Паспорт может быть законно выдан не по месту жительства, поэтому расхождение — повод для
+// дополнительной проверки, а не для отказа.
+func IsPassportRegionConsistent(series, issuedCode string) error {
+	return defaultValidator.IsPassportRegionConsistent(series, issuedCode)
+}
+
+func (v *Validator) IsPassportRegionConsistent(series, issuedCode string) error {
+	region, err := SeriesRegion(series)
+	if err != nil {
+		return err
+	}
+	if issuedCode == "" {
+		return ErrEmptyIssuedCode
+	}
+	if !issuedCodeRegexp.MatchString(issuedCode) {
+		return ErrInvalidIssuedCode
+	}
+
+	issuerSubject := issuedCode[:2]
+	if issuerSubject == region.Subject {
+		return nil
+	}
+	for _, exception := range v.regionExceptions {
+		if exception.SeriesSubject == region.Subject && exception.IssuerSubject == issuerSubject {
+			return nil
+		}
+	}
+	return ErrPassportRegionMismatch
+}
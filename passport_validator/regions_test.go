@@ -0,0 +1,175 @@
+package passport_validator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SeriesRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		series   string
+		wantName string
+		wantErr  error
+	}{
+		"moscow": {
+			series:   "4517",
+			wantName: "г. Москва",
+		},
+		"moscow region": {
+			series:   "4617",
+			wantName: "Московская область",
+		},
+		"renamed region has current name": {
+			series:   "5703",
+			wantName: "Пермский край",
+		},
+		"region since 2022": {
+			series:   "2122",
+			wantName: "Донецкая Народная Республика",
+		},
+		"unknown region": {
+			series:  "0017",
+			wantErr: ErrInvalidPassportSeriesRegion,
+		},
+		"invalid series": {
+			series:  "45",
+			wantErr: ErrInvalidPassportSeriesNot4Digits,
+		},
+		"empty series": {
+			series:  "",
+			wantErr: ErrEmptyPassportSeries,
+		},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			region, err := SeriesRegion(tt.series)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantName, region.Name)
+		})
+	}
+}
+
+func Test_RegionActiveInYear(t *testing.T) {
+	t.Parallel()
+
+	perm := okatoRegions["57"]
+	require.Len(t, perm, 2)
+
+	assert.True(t, perm[0].activeInYear(2005))
+	assert.False(t, perm[0].activeInYear(2006))
+	assert.True(t, perm[1].activeInYear(2005))
+	assert.False(t, perm[1].activeInYear(2004))
+
+	assert.False(t, seriesRegionExists("6713", 2013))
+	assert.True(t, seriesRegionExists("6714", 2014))
+	for _, okato := range []string{"21", "23", "39", "43"} {
+		assert.False(t, seriesRegionExists(okato+"21", 2021), okato)
+		assert.True(t, seriesRegionExists(okato+"22", 2022), okato)
+	}
+	assert.Equal(t, time.Date(2014, 3, 18, 0, 0, 0, 0, time.UTC), okatoRegions["67"][0].ValidFrom)
+}
+
+func Test_PassportRegionConsistent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		series     string
+		issuedCode string
+		wantErr    error
+	}{
+		"same region": {
+			series:     "4617",
+			issuedCode: "500-001",
+		},
+		"moscow": {
+			series:     "4517",
+			issuedCode: "770-001",
+		},
+		"autonomous okrug exception": {
+			series:     "7117",
+			issuedCode: "860-001",
+		},
+		"nenets okrug": {
+			series:     "1117",
+			issuedCode: "830-001",
+		},
+		"yamal okrug": {
+			series:     "7117",
+			issuedCode: "890-001",
+		},
+		"luhansk": {
+			series:     "4323",
+			issuedCode: "940-001",
+		},
+		"kherson": {
+			series:     "3923",
+			issuedCode: "950-001",
+		},
+		"region mismatch": {
+			series:     "4517",
+			issuedCode: "500-001",
+			wantErr:    ErrPassportRegionMismatch,
+		},
+		"unknown series region": {
+			series:     "0017",
+			issuedCode: "500-001",
+			wantErr:    ErrInvalidPassportSeriesRegion,
+		},
+		"invalid issued code": {
+			series:     "4617",
+			issuedCode: "50-001",
+			wantErr:    ErrInvalidIssuedCode,
+		},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := IsPassportRegionConsistent(tt.series, tt.issuedCode)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_PassportRegionMismatchIsWarning(t *testing.T) {
+	t.Parallel()
+
+	checkDate := time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC)
+	p := validPassport()
+	p.IssuerCode = "770-001"
+
+	assert.NoError(t, p.Validate(checkDate))
+
+	result := p.Check(checkDate)
+	assert.True(t, result.Valid())
+	require.Len(t, result.Errors.Warnings(), 1)
+	assert.Empty(t, result.Errors.Errors())
+	assert.ErrorIs(t, result.Errors.Warnings()[0], ErrPassportRegionMismatch)
+	assert.Equal(t, SeverityWarning, result.Errors.Warnings()[0].Severity)
+
+	v := NewValidator(WithClock(fixedClock(checkDate)), WithRegionExceptions(RegionException{SeriesSubject: "50", IssuerSubject: "77"}))
+	assert.Empty(t, v.CheckPassport(p).Errors)
+}
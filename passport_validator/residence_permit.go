@@ -0,0 +1,272 @@
+package passport_validator
+
+import (
+	"errors"
+	"regexp"
+	"time"
+)
+
+var (
+	// Вид на жительство с 2019 года — отдельный документ: серия 82 для иностранных граждан,
+	// 83 для лиц без гражданства, номер из 7 цифр
+	residencePermitSeriesRegexp = regexp.MustCompile(`^8[23]$`)
+	residencePermitNumberRegexp = regexp.MustCompile(`^\d{7}$`)
+	// Номер решения в штампе о разрешении на временное проживание, до 10 цифр
+	temporaryResidencePermitNumberRegexp = regexp.MustCompile(`^\d{1,10}$`)
+)
+
+var (
+	ErrEmptyResidencePermitSeries             = errors.New("residence permit series is empty")
+	ErrInvalidResidencePermitSeries           = errors.New("residence permit series is not 82 or 83")
+	ErrEmptyResidencePermitNumber             = errors.New("residence permit number is empty")
+	ErrInvalidResidencePermitNumber           = errors.New("residence permit number is not 7 digits")
+	ErrResidencePermitExpiryMismatch          = errors.New("expiry date does not match residence permit validity")
+	ErrResidencePermitExpired                 = errors.New("residence permit expired")
+	ErrEmptyTemporaryResidencePermitNumber    = errors.New("temporary residence permit decision number is empty")
+	ErrInvalidTemporaryResidencePermitNumber  = errors.New("temporary residence permit decision number is not 1 to 10 digits")
+	ErrTemporaryResidencePermitExpiryMismatch = errors.New("expiry date does not match temporary residence permit validity")
+	ErrTemporaryResidencePermitExpired        = errors.New("temporary residence permit expired")
+)
+
+// Пути полей с именем латиницей, которое записывается в документы иностранцев рядом с кириллицей
+const (
+	FieldLatinLastName  = "latin_last_name"
+	FieldLatinFirstName = "latin_first_name"
+)
+
+const (
+	// ResidencePermitSeriesForeign серия вида на жительство иностранного гражданина, выдается бессрочно
+	ResidencePermitSeriesForeign = "82"
+	// ResidencePermitSeriesStateless серия вида на жительство лица без гражданства
+	ResidencePermitSeriesStateless = "83"
+	// ResidencePermitStatelessValidityYears срок действия вида на жительство лица без гражданства
+	ResidencePermitStatelessValidityYears = 10
+	// TemporaryResidencePermitValidityYears срок действия разрешения на временное проживание
+	TemporaryResidencePermitValidityYears = 3
+)
+
+// ResidencePermit вид на жительство (ВНЖ) образца 2019 года. Имена записаны кириллицей,
+// латиницей — как в документе, удостоверяющем личность иностранца.
+type ResidencePermit struct {
+	LastName       string
+	FirstName      string
+	MiddleName     string
+	LatinLastName  string
+	LatinFirstName string
+	Series         string
+	Number         string
+	IssueDate      time.Time
+	// ExpiryDate срок действия, пустой для бессрочного вида на жительство иностранного гражданина
+	ExpiryDate time.Time
+	Birthday   time.Time
+}
+
+// TemporaryResidencePermit разрешение на временное проживание (РВП) — штамп в документе иностранца
+type TemporaryResidencePermit struct {
+	LastName       string
+	FirstName      string
+	MiddleName     string
+	LatinLastName  string
+	LatinFirstName string
+	// DecisionNumber номер решения о выдаче разрешения
+	DecisionNumber string
+	// IssueDate дата решения о выдаче разрешения
+	IssueDate  time.Time
+	ExpiryDate time.Time
+	Birthday   time.Time
+}
+
+func IsResidencePermitSeriesValid(series string) error {
+	if series == "" {
+		return ErrEmptyResidencePermitSeries
+	}
+	if !residencePermitSeriesRegexp.MatchString(series) {
+		return ErrInvalidResidencePermitSeries
+	}
+	return nil
+}
+
+func IsResidencePermitNumberValid(number string) error {
+	if number == "" {
+		return ErrEmptyResidencePermitNumber
+	}
+	if !residencePermitNumberRegexp.MatchString(number) {
+		return ErrInvalidResidencePermitNumber
+	}
+	return nil
+}
+
+func IsTemporaryResidencePermitNumberValid(number string) error {
+	if number == "" {
+		return ErrEmptyTemporaryResidencePermitNumber
+	}
+	if !temporaryResidencePermitNumberRegexp.MatchString(number) {
+		return ErrInvalidTemporaryResidencePermitNumber
+	}
+	return nil
+}
+
+// IsLatinNameValid проверяет имя, записанное латиницей. Пустое имя допустимо: латиницей имя
+// записывается не во всех документах.
+func IsLatinNameValid(name string) error {
+	if name == "" {
+		return nil
+	}
+	return latinNameValidator(name)
+}
+
+// IsResidencePermitExpiryValid проверяет срок действия вида на жительство серии series на дату checkDate.
+// Вид на жительство иностранного гражданина выдается бессрочно, но для отдельных категорий
+// в нем указывается срок, поэтому для серии 82 проверяется только, что срок после даты выдачи.
+func IsResidencePermitExpiryValid(series string, issueDate, expiryDate, checkDate time.Time) error {
+	return defaultValidator.residencePermitExpiryValid(series, issueDate, expiryDate, checkDate)
+}
+
+func (v *Validator) residencePermitExpiryValid(series string, issueDate, expiryDate, checkDate time.Time) error {
+	if issueDate.IsZero() {
+		return ErrEmptyIssueDate
+	}
+
+	switch series {
+	case ResidencePermitSeriesForeign:
+		if expiryDate.IsZero() {
+			return nil
+		}
+		if !civilDate(expiryDate).After(civilDate(issueDate)) {
+			return ErrResidencePermitExpiryMismatch
+		}
+	case ResidencePermitSeriesStateless:
+		if expiryDate.IsZero() {
+			return ErrEmptyExpiryDate
+		}
+		if !v.validityMatches(issueDate, expiryDate, ResidencePermitStatelessValidityYears) {
+			return ErrResidencePermitExpiryMismatch
+		}
+	default:
+		return ErrInvalidResidencePermitSeries
+	}
+
+	if civilDate(checkDate).After(civilDate(expiryDate)) {
+		return ErrResidencePermitExpired
+	}
+	return nil
+}
+
+// IsTemporaryResidencePermitExpiryValid проверяет, что разрешение выдано на 3 года и не истекло на checkDate
+func IsTemporaryResidencePermitExpiryValid(issueDate, expiryDate, checkDate time.Time) error {
+	return defaultValidator.temporaryResidencePermitExpiryValid(issueDate, expiryDate, checkDate)
+}
+
+func (v *Validator) temporaryResidencePermitExpiryValid(issueDate, expiryDate, checkDate time.Time) error {
+	if issueDate.IsZero() {
+		return ErrEmptyIssueDate
+	}
+	if expiryDate.IsZero() {
+		return ErrEmptyExpiryDate
+	}
+	if !v.validityMatches(issueDate, expiryDate, TemporaryResidencePermitValidityYears) {
+		return ErrTemporaryResidencePermitExpiryMismatch
+	}
+	if civilDate(checkDate).After(civilDate(expiryDate)) {
+		return ErrTemporaryResidencePermitExpired
+	}
+	return nil
+}
+
+// validityMatches true, если срок действия заканчивается через years лет после выдачи или днем раньше
+func (v *Validator) validityMatches(issueDate, expiryDate time.Time, years int) bool {
+	want := AnniversaryDate(issueDate, years, v.leapDayPolicy)
+	expiryDate = civilDate(expiryDate)
+	return expiryDate.Equal(want) || expiryDate.Equal(want.AddDate(0, 0, -1))
+}
+
+// residenceIssueDateValid документы иностранцев выдаются в любом возрасте, поэтому дата выдачи
+// сверяется только с датой рождения и датой проверки
+func residenceIssueDateValid(issueDate, birthday, checkDate time.Time) error {
+	if issueDate.IsZero() {
+		return ErrEmptyIssueDate
+	}
+	if birthday.IsZero() {
+		return ErrEmptyBirthday
+	}
+	if civilDate(issueDate).Before(civilDate(birthday)) {
+		return ErrIssueDateBeforeBirthday
+	}
+	if issueDate.After(checkDate) {
+		return ErrIssueDatePassportInFuture
+	}
+	return nil
+}
+
+// Validate проверяет вид на жительство на дату checkDate: имена кириллицей и латиницей, серию, номер,
+// дату выдачи и срок действия, который зависит от серии бланка
+func (p ResidencePermit) Validate(checkDate time.Time) error {
+	return p.Check(checkDate).Err()
+}
+
+// Check как Validate, но возвращает ValidationResult. Без корректной серии и даты выдачи срок действия не проверяется.
+func (p ResidencePermit) Check(checkDate time.Time) ValidationResult {
+	return defaultValidator.checkResidencePermit(p, checkDate)
+}
+
+// ValidateResidencePermit проверяет вид на жительство на текущую дату
+func (v *Validator) ValidateResidencePermit(p ResidencePermit) error {
+	return v.checkResidencePermit(p, v.clock.Now()).Err()
+}
+
+func (v *Validator) checkResidencePermit(p ResidencePermit, checkDate time.Time) ValidationResult {
+	var errs fieldErrors
+
+	v.checkForeignerNames(&errs, p.LastName, p.FirstName, p.MiddleName, p.LatinLastName, p.LatinFirstName)
+	seriesErr := IsResidencePermitSeriesValid(p.Series)
+	errs.check(FieldSeries, p.Series, seriesErr)
+	errs.check(FieldNumber, p.Number, IsResidencePermitNumberValid(p.Number))
+
+	errs.checkIssueDate(p.IssueDate, residenceIssueDateValid(p.IssueDate, p.Birthday, checkDate))
+	// Срок действия зависит от серии и даты выдачи, без них его не проверить
+	if seriesErr == nil && !p.IssueDate.IsZero() {
+		errs.check(FieldExpiryDate, formatDate(p.ExpiryDate), v.residencePermitExpiryValid(p.Series, p.IssueDate, p.ExpiryDate, checkDate))
+	}
+
+	return ValidationResult{CheckDate: checkDate, Errors: ValidationErrors(errs)}
+}
+
+// Validate проверяет разрешение на временное проживание на дату checkDate: имена, номер решения о выдаче,
+// дату выдачи и трехлетний срок действия
+func (p TemporaryResidencePermit) Validate(checkDate time.Time) error {
+	return p.Check(checkDate).Err()
+}
+
+// Check как Validate, но возвращает ValidationResult. Ошибка номера решения относится к полю number.
+func (p TemporaryResidencePermit) Check(checkDate time.Time) ValidationResult {
+	return defaultValidator.checkTemporaryResidencePermit(p, checkDate)
+}
+
+// ValidateTemporaryResidencePermit проверяет разрешение на временное проживание на текущую дату
+func (v *Validator) ValidateTemporaryResidencePermit(p TemporaryResidencePermit) error {
+	return v.checkTemporaryResidencePermit(p, v.clock.Now()).Err()
+}
+
+func (v *Validator) checkTemporaryResidencePermit(p TemporaryResidencePermit, checkDate time.Time) ValidationResult {
+	var errs fieldErrors
+
+	v.checkForeignerNames(&errs, p.LastName, p.FirstName, p.MiddleName, p.LatinLastName, p.LatinFirstName)
+	errs.check(FieldNumber, p.DecisionNumber, IsTemporaryResidencePermitNumberValid(p.DecisionNumber))
+
+	errs.checkIssueDate(p.IssueDate, residenceIssueDateValid(p.IssueDate, p.Birthday, checkDate))
+	if !p.IssueDate.IsZero() {
+		errs.check(FieldExpiryDate, formatDate(p.ExpiryDate), v.temporaryResidencePermitExpiryValid(p.IssueDate, p.ExpiryDate, checkDate))
+	}
+
+	return ValidationResult{CheckDate: checkDate, Errors: ValidationErrors(errs)}
+}
+
+// checkForeignerNames проверяет имя иностранца кириллицей и латиницей. Отчества у иностранцев
+// обычно нет, поэтому оно, как и во внутреннем паспорте, не обязательно.
+func (v *Validator) checkForeignerNames(errs *fieldErrors, lastName, firstName, middleName, latinLastName, latinFirstName string) {
+	errs.check(FieldLastName, lastName, v.IsPassportLastNameValid(lastName))
+	errs.check(FieldFirstName, firstName, v.IsPassportFirstNameValid(firstName))
+	errs.check(FieldMiddleName, middleName, v.IsPassportMiddleNameValid(middleName))
+	errs.check(FieldLatinLastName, latinLastName, IsLatinNameValid(latinLastName))
+	errs.check(FieldLatinFirstName, latinFirstName, IsLatinNameValid(latinFirstName))
+}
@@ -0,0 +1,159 @@
+package passport_validator
+
+import (
+	"testing"
+	"time"
+)
+
+func validResidencePermit() ResidencePermit {
+	return ResidencePermit{
+		LastName:       "Смит",
+		FirstName:      "Джон",
+		LatinLastName:  "SMITH",
+		LatinFirstName: "JOHN",
+		Series:         ResidencePermitSeriesForeign,
+		Number:         "1234567",
+		IssueDate:      time.Date(2021, 4, 12, 0, 0, 0, 0, time.UTC),
+		Birthday:       time.Date(1985, 9, 3, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func validTemporaryResidencePermit() TemporaryResidencePermit {
+	return TemporaryResidencePermit{
+		LastName:       "Д'Артаньян",
+		FirstName:      "Шарль",
+		LatinLastName:  "D'ARTAGNAN",
+		LatinFirstName: "CHARLES",
+		DecisionNumber: "1523",
+		IssueDate:      time.Date(2022, 6, 20, 0, 0, 0, 0, time.UTC),
+		ExpiryDate:     time.Date(2025, 6, 20, 0, 0, 0, 0, time.UTC),
+		Birthday:       time.Date(1990, 1, 31, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func Test_ResidencePermitValidate(t *testing.T) {
+	t.Parallel()
+
+	checkDate := time.Date(2024, 2, 27, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]documentCase[ResidencePermit]{
+		"indefinite": {
+			modify: func(p *ResidencePermit) {},
+		},
+		"foreign with expiry": {
+			modify: func(p *ResidencePermit) { p.ExpiryDate = time.Date(2026, 4, 12, 0, 0, 0, 0, time.UTC) },
+		},
+		"stateless": {
+			modify: func(p *ResidencePermit) {
+				p.Series = ResidencePermitSeriesStateless
+				p.ExpiryDate = time.Date(2031, 4, 11, 0, 0, 0, 0, time.UTC)
+			},
+		},
+		"without latin name": {
+			modify: func(p *ResidencePermit) { p.LatinLastName, p.LatinFirstName = "", "" },
+		},
+		"stateless without expiry": {
+			modify:    func(p *ResidencePermit) { p.Series = ResidencePermitSeriesStateless },
+			wantField: FieldExpiryDate,
+			wantErr:   ErrEmptyExpiryDate,
+		},
+		"stateless wrong validity": {
+			modify: func(p *ResidencePermit) {
+				p.Series = ResidencePermitSeriesStateless
+				p.ExpiryDate = time.Date(2026, 4, 12, 0, 0, 0, 0, time.UTC)
+			},
+			wantField: FieldExpiryDate,
+			wantErr:   ErrResidencePermitExpiryMismatch,
+		},
+		"expired": {
+			modify:    func(p *ResidencePermit) { p.ExpiryDate = time.Date(2023, 4, 12, 0, 0, 0, 0, time.UTC) },
+			wantField: FieldExpiryDate,
+			wantErr:   ErrResidencePermitExpired,
+		},
+		"empty series": {
+			modify:    func(p *ResidencePermit) { p.Series = "" },
+			wantField: FieldSeries,
+			wantErr:   ErrEmptyResidencePermitSeries,
+		},
+		"old series": {
+			modify:    func(p *ResidencePermit) { p.Series = "81" },
+			wantField: FieldSeries,
+			wantErr:   ErrInvalidResidencePermitSeries,
+		},
+		"empty number": {
+			modify:    func(p *ResidencePermit) { p.Number = "" },
+			wantField: FieldNumber,
+			wantErr:   ErrEmptyResidencePermitNumber,
+		},
+		"short number": {
+			modify:    func(p *ResidencePermit) { p.Number = "123456" },
+			wantField: FieldNumber,
+			wantErr:   ErrInvalidResidencePermitNumber,
+		},
+		"cyrillic in latin name": {
+			modify:    func(p *ResidencePermit) { p.LatinLastName = "СМИТ" },
+			wantField: FieldLatinLastName,
+			wantErr:   ErrNonLatinCharacter,
+		},
+		"latin in cyrillic name": {
+			modify:    func(p *ResidencePermit) { p.FirstName = "John" },
+			wantField: FieldFirstName,
+			wantErr:   ErrNonCyrillicCharacter,
+		},
+		"issued before birthday": {
+			modify:    func(p *ResidencePermit) { p.Birthday = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC) },
+			wantField: FieldIssueDate,
+			wantErr:   ErrIssueDateBeforeBirthday,
+		},
+	}
+
+	runDocumentCases(t, validResidencePermit, checkDate, testCases)
+}
+
+func Test_TemporaryResidencePermitValidate(t *testing.T) {
+	t.Parallel()
+
+	checkDate := time.Date(2024, 2, 27, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]documentCase[TemporaryResidencePermit]{
+		"valid": {
+			modify: func(p *TemporaryResidencePermit) {},
+		},
+		"expiry day before anniversary": {
+			modify: func(p *TemporaryResidencePermit) { p.ExpiryDate = time.Date(2025, 6, 19, 0, 0, 0, 0, time.UTC) },
+		},
+		"wrong validity": {
+			modify:    func(p *TemporaryResidencePermit) { p.ExpiryDate = time.Date(2027, 6, 20, 0, 0, 0, 0, time.UTC) },
+			wantField: FieldExpiryDate,
+			wantErr:   ErrTemporaryResidencePermitExpiryMismatch,
+		},
+		"expired": {
+			modify:    func(p *TemporaryResidencePermit) {},
+			checkDate: time.Date(2025, 6, 21, 0, 0, 0, 0, time.UTC),
+			wantField: FieldExpiryDate,
+			wantErr:   ErrTemporaryResidencePermitExpired,
+		},
+		"empty expiry": {
+			modify:    func(p *TemporaryResidencePermit) { p.ExpiryDate = time.Time{} },
+			wantField: FieldExpiryDate,
+			wantErr:   ErrEmptyExpiryDate,
+		},
+		"empty decision number": {
+			modify:    func(p *TemporaryResidencePermit) { p.DecisionNumber = "" },
+			wantField: FieldNumber,
+			wantErr:   ErrEmptyTemporaryResidencePermitNumber,
+		},
+		"invalid decision number": {
+			modify:    func(p *TemporaryResidencePermit) { p.DecisionNumber = "15/23" },
+			wantField: FieldNumber,
+			wantErr:   ErrInvalidTemporaryResidencePermitNumber,
+		},
+		"empty birthday": {
+			modify:    func(p *TemporaryResidencePermit) { p.Birthday = time.Time{} },
+			wantField: FieldBirthday,
+			wantErr:   ErrEmptyBirthday,
+		},
+	}
+
+	runDocumentCases(t, validTemporaryResidencePermit, checkDate, testCases)
+}
@@ -0,0 +1,107 @@
+package passport_validator
+
+import (
+	"sort"
+	"time"
+)
+
+// RuleSet правила выдачи и замены паспорта, действовавшие начиная с EffectiveFrom.
+// Правила выбираются по дате выдачи паспорта, поэтому добавление нового RuleSet не меняет
+// решения по паспортам, выданным раньше.
+type RuleSet struct {
+	// Version идентификатор набора правил, сохраняется в ValidationResult для аудита
+	Version       string
+	EffectiveFrom time.Time
+	// MinIssueAge возраст, с которого выдается паспорт
+	MinIssueAge int
+	// ReplacementAges возраста, по достижении которых паспорт подлежит замене, по возрастанию
+	ReplacementAges []int
+	// GraceDays сколько дней после достижения возраста замены паспорт еще действителен
+	GraceDays int
+	// BlankReleaseYear год появления бланка, раньше которого не может быть год в серии
+	BlankReleaseYear int
+}
+
+// RuleSet1997 правила постановления Правительства РФ от 8 июля 1997 г. N 828,
+// по которым паспорта нового образца выдаются с 1 октября 1997 года.
+// Каждый вызов возвращает новую копию, изменять ее можно.
+func RuleSet1997() RuleSet {
+	return RuleSet{
+		Version:          "828-1997",
+		EffectiveFrom:    time.Date(1997, time.October, 1, 0, 0, 0, 0, time.UTC),
+		MinIssueAge:      14,
+		ReplacementAges:  []int{Age20PassportChange, Age45PassportChange},
+		GraceDays:        PassportDaysValidity,
+		BlankReleaseYear: 1997,
+	}
+}
+
+// DefaultRuleSets наборы правил, которые используются по умолчанию
+func DefaultRuleSets() []RuleSet {
+	return []RuleSet{RuleSet1997()}
+}
+
+// WithRuleSets заменяет наборы правил валидатора. Чтобы добавить новые правила,
+// сохранив старые решения, передайте append(DefaultRuleSets(), newRuleSet).
+// Наборы копируются, поэтому их изменение после вызова не влияет на валидатор.
+func WithRuleSets(ruleSets ...RuleSet) Option {
+	return func(v *Validator) {
+		v.ruleSets = make([]RuleSet, len(ruleSets))
+		for i, rs := range ruleSets {
+			rs.ReplacementAges = append([]int(nil), rs.ReplacementAges...)
+			v.ruleSets[i] = rs
+		}
+	}
+}
+
+// RuleSetAt набор правил, действовавший на дату date. Для дат раньше самого раннего набора
+// возвращается самый ранний.
+func (v *Validator) RuleSetAt(date time.Time) RuleSet {
+	ruleSet := v.ruleSets[0]
+	for _, rs := range v.ruleSets[1:] {
+		if date.Before(rs.EffectiveFrom) {
+			break
+		}
+		ruleSet = rs
+	}
+	return ruleSet
+}
+
+// RuleSetAt набор правил по умолчанию, действовавший на дату date
+func RuleSetAt(date time.Time) RuleSet {
+	return defaultValidator.RuleSetAt(date)
+}
+
+// prepareRuleSets сортирует наборы правил по дате начала действия и применяет WithGraceDays
+func (v *Validator) prepareRuleSets() {
+	if len(v.ruleSets) == 0 {
+		v.ruleSets = DefaultRuleSets()
+	}
+	sort.SliceStable(v.ruleSets, func(i, j int) bool {
+		return v.ruleSets[i].EffectiveFrom.Before(v.ruleSets[j].EffectiveFrom)
+	})
+	if v.graceDays != nil {
+		for i := range v.ruleSets {
+			v.ruleSets[i].GraceDays = *v.graceDays
+		}
+	}
+}
+
+// ValidationResult итог проверки документа вместе с версией правил, по которым он проверен
+type ValidationResult struct {
+	CheckDate time.Time
+	// RuleSet версия набора правил, см. RuleSet.Version
+	RuleSet string
+	// Errors ошибки и предупреждения проверки
+	Errors ValidationErrors
+}
+
+// Valid true, если не найдено ошибок с SeverityError. Предупреждения не делают документ недействительным.
+func (r ValidationResult) Valid() bool {
+	return len(r.Errors.Errors()) == 0
+}
+
+// Err ошибки проверки с SeverityError или nil, предупреждения доступны в Errors
+func (r ValidationResult) Err() error {
+	return r.Errors.Errors().err()
+}
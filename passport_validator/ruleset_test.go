@@ -0,0 +1,132 @@
+package passport_validator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ruleSet2030 вымышленные правила для проверки версионирования: замена в 25 и 50 лет
+var ruleSet2030 = RuleSet{
+	Version:          "test-2030",
+	EffectiveFrom:    time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC),
+	MinIssueAge:      14,
+	ReplacementAges:  []int{25, 50},
+	GraceDays:        30,
+	BlankReleaseYear: 1997,
+}
+
+func Test_RuleSetAt(t *testing.T) {
+	t.Parallel()
+
+	v := NewValidator(WithRuleSets(ruleSet2030, RuleSet1997()))
+
+	testCases := map[string]struct {
+		date        time.Time
+		wantVersion string
+	}{
+		"before first rule set": {
+			date:        time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantVersion: "828-1997",
+		},
+		"1997 rules": {
+			date:        time.Date(2005, 6, 1, 0, 0, 0, 0, time.UTC),
+			wantVersion: "828-1997",
+		},
+		"new rules effective date": {
+			date:        time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantVersion: "test-2030",
+		},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.wantVersion, v.RuleSetAt(tt.date).Version)
+		})
+	}
+}
+
+func Test_RuleSetsKeepOldDecisions(t *testing.T) {
+	t.Parallel()
+
+	birthday := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	checkDate := time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)
+	v := NewValidator(WithRuleSets(append(DefaultRuleSets(), ruleSet2030)...))
+
+	// Выдан в 2014 году: действуют правила 1997 года, замена в 20 лет
+	expiration, err := v.expirationInfo(time.Date(2014, 2, 1, 0, 0, 0, 0, time.UTC), birthday, checkDate)
+	require.NoError(t, err)
+	assert.Equal(t, "828-1997", expiration.RuleSet)
+	assert.Equal(t, 20, expiration.ReplacementAge)
+	assert.Equal(t, ExpirationExpired, expiration.Status)
+
+	// Выдан в 2030 году в 30 лет: действуют новые правила, замена в 50 лет
+	expiration, err = v.expirationInfo(time.Date(2030, 2, 1, 0, 0, 0, 0, time.UTC), birthday, checkDate)
+	require.NoError(t, err)
+	assert.Equal(t, "test-2030", expiration.RuleSet)
+	assert.Equal(t, 50, expiration.ReplacementAge)
+	assert.Equal(t, ExpirationValid, expiration.Status)
+}
+
+func Test_PassportCheckRecordsRuleSet(t *testing.T) {
+	t.Parallel()
+
+	result := validPassport().Check(time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC))
+
+	assert.True(t, result.Valid())
+	assert.NoError(t, result.Err())
+	assert.Equal(t, "828-1997", result.RuleSet)
+	assert.Equal(t, time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC), result.CheckDate)
+}
+
+func Test_WithGraceDaysOverridesRuleSets(t *testing.T) {
+	t.Parallel()
+
+	v := NewValidator(WithGraceDays(10), WithRuleSets(ruleSet2030, RuleSet1997()))
+
+	assert.Equal(t, 10, v.RuleSetAt(time.Date(2005, 6, 1, 0, 0, 0, 0, time.UTC)).GraceDays)
+	assert.Equal(t, 10, v.RuleSetAt(time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC)).GraceDays)
+	assert.Equal(t, PassportDaysValidity, RuleSet1997().GraceDays)
+}
+
+func Test_PassportExpiredAtCustomReplacementAge(t *testing.T) {
+	t.Parallel()
+
+	ruleSet := RuleSet1997()
+	ruleSet.ReplacementAges = []int{25, 45}
+	v := NewValidator(WithRuleSets(ruleSet))
+
+	result := v.checkPassport(validPassport(), time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC))
+
+	require.Len(t, result.Errors, 1)
+	err := result.Errors[0]
+	assert.Equal(t, FieldIssueDate, err.Field)
+	assert.Equal(t, "expired_at_age", err.Code)
+	assert.ErrorIs(t, err, ErrIssueDatePassportExpired)
+	assert.NotErrorIs(t, err, ErrIssueDatePassportExpiredAt45)
+	var ageErr *PassportExpiredAtAgeError
+	require.ErrorAs(t, err, &ageErr)
+	assert.Equal(t, 25, ageErr.Age)
+	assert.Equal(t,
+		"Паспорт недействителен после 2022-05-22: требуется замена по достижении 25 лет",
+		Localize(err, LangRU),
+	)
+}
+
+func Test_RuleSetsAreNotShared(t *testing.T) {
+	t.Parallel()
+
+	ruleSet := RuleSet1997()
+	ruleSet.ReplacementAges[0] = 25
+	assert.Equal(t, []int{20, 45}, RuleSet1997().ReplacementAges)
+
+	ages := []int{25, 50}
+	v := NewValidator(WithRuleSets(RuleSet{EffectiveFrom: ruleSet.EffectiveFrom, ReplacementAges: ages}))
+	ages[0] = 30
+	assert.Equal(t, []int{25, 50}, v.RuleSetAt(ruleSet.EffectiveFrom).ReplacementAges)
+}
@@ -0,0 +1,129 @@
+package passport_validator
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrSovietPassportSeries серия похожа на серию паспорта СССР. Оборачивает ErrInvalidPassportSeriesNot4Digits,
+	// поэтому проверки errors.Is(err, ErrInvalidPassportSeriesNot4Digits) продолжают работать.
+	ErrSovietPassportSeries = fmt.Errorf("passport series belongs to a 1974 Soviet passport: %w", ErrInvalidPassportSeriesNot4Digits)
+
+	ErrInvalidSovietPassportSeries       = errors.New("Soviet passport series is not a Roman numeral, hyphen and 2 Cyrillic letters")
+	ErrInvalidSovietPassportNumber       = errors.New("Soviet passport number is not 6 digits")
+	ErrSovietPassportIssuedBefore16      = errors.New("Soviet passport issued before sixteenth birthday")
+	ErrSovietPassportNoLongerValid       = errors.New("Soviet passport is not valid after the replacement deadline")
+	ErrSovietPassportIssuedAfterDeadline = errors.New("Soviet passport issued after the replacement deadline")
+)
+
+// SovietPassportMinIssueAge возраст, с которого выдавался паспорт образца 1974 года
+const SovietPassportMinIssueAge = 16
+
+// sovietPassportInvalidFrom срок обязательной замены на паспорт гражданина РФ закончился 30 июня 2004 года
+var sovietPassportInvalidFrom = time.Date(2004, time.July, 1, 0, 0, 0, 0, time.UTC)
+
+// SovietPassportInvalidFrom дата, с которой паспорта образца 1974 года недействительны
+func SovietPassportInvalidFrom() time.Time {
+	return sovietPassportInvalidFrom
+}
+
+// SovietPassport данные паспорта гражданина СССР образца 1974 года
+type SovietPassport struct {
+	LastName   string
+	FirstName  string
+	MiddleName string
+	Series     string
+	Number     string
+	IssueDate  time.Time
+	Birthday   time.Time
+}
+
+// IsSovietPassportSeriesValid проверяет формат серии паспорта СССР, например "IV-МЮ"
+func IsSovietPassportSeriesValid(series string) error {
+	if series == "" {
+		return ErrEmptyPassportSeries
+	}
+	if formatValid, numeralValid := romanSeriesValid(series); !formatValid || !numeralValid {
+		return ErrInvalidSovietPassportSeries
+	}
+	return nil
+}
+
+func IsSovietPassportNumberValid(number string) error {
+	if number == "" {
+		return ErrEmptyPassportNumber
+	}
+	if !passportNumberRegexp.MatchString(number) {
+		return ErrInvalidSovietPassportNumber
+	}
+	return nil
+}
+
+// IsSovietPassportValidAt проверяет, что паспорт образца 1974 года еще действовал на checkDate
+func IsSovietPassportValidAt(checkDate time.Time) error {
+	if !civilDate(checkDate).Before(sovietPassportInvalidFrom) {
+		return ErrSovietPassportNoLongerValid
+	}
+	return nil
+}
+
+// IsSovietPassportIssueDateValid проверяет дату выдачи паспорта СССР относительно даты рождения
+func (v *Validator) IsSovietPassportIssueDateValid(issueDate, birthday time.Time) error {
+	return v.sovietIssueDateValid(issueDate, birthday, v.clock.Now())
+}
+
+func IsSovietPassportIssueDateValid(issueDate, birthday, checkDate time.Time) error {
+	return defaultValidator.sovietIssueDateValid(issueDate, birthday, checkDate)
+}
+
+func (v *Validator) sovietIssueDateValid(issueDate, birthday, checkDate time.Time) error {
+	if issueDate.IsZero() {
+		return ErrEmptyIssueDate
+	}
+	if birthday.IsZero() {
+		return ErrEmptyBirthday
+	}
+	if AgeAt(birthday, issueDate, v.leapDayPolicy).Years < SovietPassportMinIssueAge {
+		return ErrSovietPassportIssuedBefore16
+	}
+	if !civilDate(issueDate).Before(sovietPassportInvalidFrom) {
+		return ErrSovietPassportIssuedAfterDeadline
+	}
+	if issueDate.After(checkDate) {
+		return ErrIssueDatePassportInFuture
+	}
+	return nil
+}
+
+// Validate проверяет паспорт СССР на дату checkDate. После SovietPassportInvalidFrom() любой такой паспорт
+// недействителен, ошибка ErrSovietPassportNoLongerValid объясняет клиенту причину отказа.
+func (p SovietPassport) Validate(checkDate time.Time) error {
+	return p.Check(checkDate).Err()
+}
+
+// Check возвращает ошибки полей паспорта СССР, а недействительность образца 1974 года — на поле document_type
+func (p SovietPassport) Check(checkDate time.Time) ValidationResult {
+	return defaultValidator.checkSovietPassport(p, checkDate)
+}
+
+// ValidateSovietPassport проверяет паспорт СССР на текущую дату
+func (v *Validator) ValidateSovietPassport(p SovietPassport) error {
+	return v.checkSovietPassport(p, v.clock.Now()).Err()
+}
+
+func (v *Validator) checkSovietPassport(p SovietPassport, checkDate time.Time) ValidationResult {
+	var errs fieldErrors
+
+	errs.check(FieldLastName, p.LastName, v.IsPassportLastNameValid(p.LastName))
+	errs.check(FieldFirstName, p.FirstName, v.IsPassportFirstNameValid(p.FirstName))
+	errs.check(FieldMiddleName, p.MiddleName, v.IsPassportMiddleNameValid(p.MiddleName))
+	errs.check(FieldSeries, p.Series, IsSovietPassportSeriesValid(p.Series))
+	errs.check(FieldNumber, p.Number, IsSovietPassportNumberValid(p.Number))
+
+	errs.checkIssueDate(p.IssueDate, v.sovietIssueDateValid(p.IssueDate, p.Birthday, checkDate))
+	errs.check(FieldDocumentType, "soviet_passport", IsSovietPassportValidAt(checkDate))
+
+	return ValidationResult{CheckDate: checkDate, Errors: ValidationErrors(errs)}
+}
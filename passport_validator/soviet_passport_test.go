@@ -0,0 +1,101 @@
+package passport_validator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_IsPassportSeriesValidSoviet(t *testing.T) {
+	t.Parallel()
+
+	err := IsPassportSeriesValid("IV-МЮ", time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC))
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrSovietPassportSeries)
+	assert.ErrorIs(t, err, ErrInvalidPassportSeriesNot4Digits)
+	assert.Equal(t, "soviet_passport_series", ErrorCode(err))
+
+	err = IsPassportSeriesValid("IIII-МЮ", time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC))
+	assert.ErrorIs(t, err, ErrInvalidPassportSeriesNot4Digits)
+	assert.NotErrorIs(t, err, ErrSovietPassportSeries)
+}
+
+func Test_SovietPassportValidate(t *testing.T) {
+	t.Parallel()
+
+	valid := SovietPassport{
+		LastName:   "Петров",
+		FirstName:  "Петр",
+		MiddleName: "Петрович",
+		Series:     "XIV-АБ",
+		Number:     "654321",
+		IssueDate:  time.Date(1980, 5, 12, 0, 0, 0, 0, time.UTC),
+		Birthday:   time.Date(1960, 1, 15, 0, 0, 0, 0, time.UTC),
+	}
+
+	testCases := map[string]struct {
+		modify    func(p *SovietPassport)
+		checkDate time.Time
+		wantField string
+		wantErr   error
+	}{
+		"valid before deadline": {
+			modify:    func(p *SovietPassport) {},
+			checkDate: time.Date(2004, 6, 30, 0, 0, 0, 0, time.UTC),
+		},
+		"not valid after deadline": {
+			modify:    func(p *SovietPassport) {},
+			checkDate: time.Date(2004, 7, 1, 0, 0, 0, 0, time.UTC),
+			wantField: FieldDocumentType,
+			wantErr:   ErrSovietPassportNoLongerValid,
+		},
+		"modern series": {
+			modify:    func(p *SovietPassport) { p.Series = "4617" },
+			checkDate: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantField: FieldSeries,
+			wantErr:   ErrInvalidSovietPassportSeries,
+		},
+		"invalid number": {
+			modify:    func(p *SovietPassport) { p.Number = "6543210" },
+			checkDate: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantField: FieldNumber,
+			wantErr:   ErrInvalidSovietPassportNumber,
+		},
+		"issued before 16": {
+			modify:    func(p *SovietPassport) { p.IssueDate = time.Date(1975, 5, 12, 0, 0, 0, 0, time.UTC) },
+			checkDate: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantField: FieldIssueDate,
+			wantErr:   ErrSovietPassportIssuedBefore16,
+		},
+		"issued after deadline": {
+			modify:    func(p *SovietPassport) { p.IssueDate = time.Date(2004, 7, 1, 0, 0, 0, 0, time.UTC) },
+			checkDate: time.Date(2004, 6, 1, 0, 0, 0, 0, time.UTC),
+			wantField: FieldIssueDate,
+			wantErr:   ErrSovietPassportIssuedAfterDeadline,
+		},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			p := valid
+			tt.modify(&p)
+
+			err := p.Validate(tt.checkDate)
+			if tt.wantErr != nil {
+				var errs ValidationErrors
+				require.ErrorAs(t, err, &errs)
+				require.Len(t, errs, 1)
+				assert.Equal(t, tt.wantField, errs[0].Field)
+				assert.ErrorIs(t, errs[0], tt.wantErr)
+
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package passport_validator
+
+import (
+	"errors"
+	"regexp"
+	"time"
+)
+
+// FieldExtensions путь поля отметок о продлении временного удостоверения
+const FieldExtensions = "extensions"
+
+var (
+	// Номер бланка формы 2П печатается типографией, в разных регионах он состоит из 6–12 цифр
+	temporaryIDNumberRegexp = regexp.MustCompile(`^\d{6,12}$`)
+)
+
+var (
+	ErrEmptyTemporaryIDNumber       = errors.New("temporary ID number is empty")
+	ErrInvalidTemporaryIDNumber     = errors.New("temporary ID number is not 6 to 12 digits")
+	ErrTemporaryIDValidityTooLong   = errors.New("temporary ID expiry date exceeds validity period")
+	ErrTemporaryIDExpiryBeforeIssue = errors.New("temporary ID expiry date is before issue date")
+	ErrInvalidTemporaryIDExtension  = errors.New("temporary ID extension is not after previous expiry or exceeds validity period")
+	ErrTemporaryIDExpired           = errors.New("temporary ID expired")
+)
+
+// TemporaryID временное удостоверение личности гражданина РФ (форма 2П), которое выдается
+// на время оформления паспорта
+type TemporaryID struct {
+	LastName   string
+	FirstName  string
+	MiddleName string
+	Number     string
+	IssuerCode string
+	IssueDate  time.Time
+	Birthday   time.Time
+	// ExpiryDate срок действия, указанный при выдаче
+	ExpiryDate time.Time
+	// Extensions даты, до которых удостоверение продлевалось, в порядке отметок
+	Extensions []time.Time
+}
+
+// TemporaryIDExpiration сведения о сроке действия временного удостоверения
+type TemporaryIDExpiration struct {
+	// Status ExpirationValid или ExpirationExpired
+	Status ExpirationStatus
+	// ExpiryDate последний день действия с учетом продлений
+	ExpiryDate time.Time
+	// Extensions сколько раз удостоверение продлевалось
+	Extensions int
+	// DaysRemaining сколько дней осталось до ExpiryDate, если удостоверение действительно
+	DaysRemaining int
+	// DaysOverdue сколько дней прошло после ExpiryDate, если удостоверение недействительно
+	DaysOverdue int
+}
+
+func IsTemporaryIDNumberValid(number string) error {
+	if number == "" {
+		return ErrEmptyTemporaryIDNumber
+	}
+	if !temporaryIDNumberRegexp.MatchString(number) {
+		return ErrInvalidTemporaryIDNumber
+	}
+	return nil
+}
+
+// IsTemporaryIDIssueDateValid проверяет дату выдачи временного удостоверения: оно выдается
+// с того же возраста, что и паспорт
+func IsTemporaryIDIssueDateValid(issueDate, birthday, checkDate time.Time) error {
+	return defaultValidator.temporaryIDIssueDateValid(issueDate, birthday, checkDate)
+}
+
+func (v *Validator) temporaryIDIssueDateValid(issueDate, birthday, checkDate time.Time) error {
+	if issueDate.IsZero() {
+		return ErrEmptyIssueDate
+	}
+	if birthday.IsZero() {
+		return ErrEmptyBirthday
+	}
+	if AgeAt(birthday, issueDate, v.leapDayPolicy).Years < v.RuleSetAt(issueDate).MinIssueAge {
+		return ErrInvalidIssueDateBefore14Birthday
+	}
+	if issueDate.After(checkDate) {
+		return ErrIssueDatePassportInFuture
+	}
+	return nil
+}
+
+// TemporaryIDExpirationInfo рассчитывает срок действия временного удостоверения на дату checkDate
+func TemporaryIDExpirationInfo(id TemporaryID, checkDate time.Time) (TemporaryIDExpiration, error) {
+	return defaultValidator.temporaryIDExpirationInfo(id, checkDate)
+}
+
+// TemporaryIDExpirationInfo рассчитывает срок действия временного удостоверения на текущую дату
+func (v *Validator) TemporaryIDExpirationInfo(id TemporaryID) (TemporaryIDExpiration, error) {
+	return v.temporaryIDExpirationInfo(id, v.clock.Now())
+}
+
+func (v *Validator) temporaryIDExpirationInfo(id TemporaryID, checkDate time.Time) (TemporaryIDExpiration, error) {
+	if id.IssueDate.IsZero() {
+		return TemporaryIDExpiration{}, ErrEmptyIssueDate
+	}
+	if id.ExpiryDate.IsZero() {
+		return TemporaryIDExpiration{}, ErrEmptyExpiryDate
+	}
+
+	expiryDate := civilDate(id.ExpiryDate)
+	if expiryDate.Before(civilDate(id.IssueDate)) {
+		return TemporaryIDExpiration{}, ErrTemporaryIDExpiryBeforeIssue
+	}
+	if expiryDate.After(civilDate(id.IssueDate).AddDate(0, v.temporaryIDValidityMonths, 0)) {
+		return TemporaryIDExpiration{}, ErrTemporaryIDValidityTooLong
+	}
+
+	// Каждое продление действует не дольше срока, на который удостоверение выдается
+	for _, extension := range id.Extensions {
+		extension = civilDate(extension)
+		if !extension.After(expiryDate) || extension.After(expiryDate.AddDate(0, v.temporaryIDValidityMonths, 0)) {
+			return TemporaryIDExpiration{}, ErrInvalidTemporaryIDExtension
+		}
+		expiryDate = extension
+	}
+
+	expiration := TemporaryIDExpiration{ExpiryDate: expiryDate, Extensions: len(id.Extensions)}
+	if civilDate(checkDate).After(expiryDate) {
+		expiration.Status = ExpirationExpired
+		expiration.DaysOverdue = daysBetween(expiryDate, checkDate)
+	} else {
+		expiration.Status = ExpirationValid
+		expiration.DaysRemaining = daysBetween(checkDate, expiryDate)
+	}
+	return expiration, nil
+}
+
+// Validate проверяет временное удостоверение на дату checkDate: ФИО, номер, код подразделения,
+// дату выдачи и срок действия с учетом отметок о продлении
+func (id TemporaryID) Validate(checkDate time.Time) error {
+	return id.Check(checkDate).Err()
+}
+
+// Check как Validate, но возвращает ValidationResult. Неверная отметка о продлении относится к полю extensions.
+func (id TemporaryID) Check(checkDate time.Time) ValidationResult {
+	return defaultValidator.checkTemporaryID(id, checkDate)
+}
+
+// ValidateTemporaryID проверяет временное удостоверение на текущую дату
+func (v *Validator) ValidateTemporaryID(id TemporaryID) error {
+	return v.checkTemporaryID(id, v.clock.Now()).Err()
+}
+
+func (v *Validator) checkTemporaryID(id TemporaryID, checkDate time.Time) ValidationResult {
+	var errs fieldErrors
+
+	errs.check(FieldLastName, id.LastName, v.IsPassportLastNameValid(id.LastName))
+	errs.check(FieldFirstName, id.FirstName, v.IsPassportFirstNameValid(id.FirstName))
+	errs.check(FieldMiddleName, id.MiddleName, v.IsPassportMiddleNameValid(id.MiddleName))
+	errs.check(FieldNumber, id.Number, IsTemporaryIDNumberValid(id.Number))
+	errs.check(FieldIssuerCode, id.IssuerCode, v.IsPassportIssuerCodeValid(id.IssuerCode))
+
+	errs.checkIssueDate(id.IssueDate, v.temporaryIDIssueDateValid(id.IssueDate, id.Birthday, checkDate))
+
+	expiration, err := v.temporaryIDExpirationInfo(id, checkDate)
+	switch {
+	case errors.Is(err, ErrEmptyIssueDate):
+		// Уже отмечено в поле даты выдачи
+	case errors.Is(err, ErrInvalidTemporaryIDExtension):
+		errs.check(FieldExtensions, "", err)
+	case err != nil:
+		errs.check(FieldExpiryDate, formatDate(id.ExpiryDate), err)
+	case expiration.Status == ExpirationExpired:
+		errs.check(FieldExpiryDate, formatDate(expiration.ExpiryDate), ErrTemporaryIDExpired)
+	}
+
+	return ValidationResult{CheckDate: checkDate, Errors: ValidationErrors(errs)}
+}
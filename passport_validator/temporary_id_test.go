@@ -0,0 +1,202 @@
+package passport_validator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validTemporaryID() TemporaryID {
+	return TemporaryID{
+		LastName:   "Иванов",
+		FirstName:  "Иван",
+		MiddleName: "Иванович",
+		Number:     "77001234",
+		IssuerCode: "770-001",
+		IssueDate:  time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		Birthday:   time.Date(1990, 7, 1, 0, 0, 0, 0, time.UTC),
+		ExpiryDate: time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func Test_TemporaryIDExpirationInfo(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		modify    func(id *TemporaryID)
+		opts      []Option
+		checkDate time.Time
+		want      TemporaryIDExpiration
+		wantErr   error
+	}{
+		"valid": {
+			modify:    func(id *TemporaryID) {},
+			checkDate: time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC),
+			want: TemporaryIDExpiration{
+				Status:        ExpirationValid,
+				ExpiryDate:    time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC),
+				DaysRemaining: 10,
+			},
+		},
+		"last day": {
+			modify:    func(id *TemporaryID) {},
+			checkDate: time.Date(2024, 2, 15, 18, 0, 0, 0, time.UTC),
+			want: TemporaryIDExpiration{
+				Status:     ExpirationValid,
+				ExpiryDate: time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"expired": {
+			modify:    func(id *TemporaryID) {},
+			checkDate: time.Date(2024, 2, 20, 0, 0, 0, 0, time.UTC),
+			want: TemporaryIDExpiration{
+				Status:      ExpirationExpired,
+				ExpiryDate:  time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC),
+				DaysOverdue: 5,
+			},
+		},
+		"extended twice": {
+			modify: func(id *TemporaryID) {
+				id.Extensions = []time.Time{
+					time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+					time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC),
+				}
+			},
+			checkDate: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+			want: TemporaryIDExpiration{
+				Status:        ExpirationValid,
+				ExpiryDate:    time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC),
+				Extensions:    2,
+				DaysRemaining: 9,
+			},
+		},
+		"extension too long": {
+			modify: func(id *TemporaryID) {
+				id.Extensions = []time.Time{time.Date(2024, 3, 16, 0, 0, 0, 0, time.UTC)}
+			},
+			checkDate: time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC),
+			wantErr:   ErrInvalidTemporaryIDExtension,
+		},
+		"extension before expiry": {
+			modify: func(id *TemporaryID) {
+				id.Extensions = []time.Time{time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC)}
+			},
+			checkDate: time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC),
+			wantErr:   ErrInvalidTemporaryIDExtension,
+		},
+		"validity too long": {
+			modify:    func(id *TemporaryID) { id.ExpiryDate = time.Date(2024, 2, 16, 0, 0, 0, 0, time.UTC) },
+			checkDate: time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC),
+			wantErr:   ErrTemporaryIDValidityTooLong,
+		},
+		"custom validity": {
+			modify:    func(id *TemporaryID) { id.ExpiryDate = time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC) },
+			opts:      []Option{WithTemporaryIDValidity(2)},
+			checkDate: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC),
+			want: TemporaryIDExpiration{
+				Status:        ExpirationValid,
+				ExpiryDate:    time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+				DaysRemaining: 10,
+			},
+		},
+		"expiry before issue": {
+			modify:    func(id *TemporaryID) { id.ExpiryDate = time.Date(2024, 1, 14, 0, 0, 0, 0, time.UTC) },
+			checkDate: time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC),
+			wantErr:   ErrTemporaryIDExpiryBeforeIssue,
+		},
+		"empty expiry": {
+			modify:    func(id *TemporaryID) { id.ExpiryDate = time.Time{} },
+			checkDate: time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC),
+			wantErr:   ErrEmptyExpiryDate,
+		},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			id := validTemporaryID()
+			tt.modify(&id)
+
+			got, err := NewValidator(tt.opts...).temporaryIDExpirationInfo(id, tt.checkDate)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_TemporaryIDValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]documentCase[TemporaryID]{
+		"valid": {
+			modify:    func(id *TemporaryID) {},
+			checkDate: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"empty number": {
+			modify:    func(id *TemporaryID) { id.Number = "" },
+			checkDate: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			wantField: FieldNumber,
+			wantErr:   ErrEmptyTemporaryIDNumber,
+		},
+		"invalid number": {
+			modify:    func(id *TemporaryID) { id.Number = "12345" },
+			checkDate: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			wantField: FieldNumber,
+			wantErr:   ErrInvalidTemporaryIDNumber,
+		},
+		"invalid issuer code": {
+			modify:    func(id *TemporaryID) { id.IssuerCode = "77-001" },
+			checkDate: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			wantField: FieldIssuerCode,
+			wantErr:   ErrInvalidIssuedCode,
+		},
+		"issued before 14": {
+			modify:    func(id *TemporaryID) { id.Birthday = time.Date(2010, 7, 1, 0, 0, 0, 0, time.UTC) },
+			checkDate: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			wantField: FieldIssueDate,
+			wantErr:   ErrInvalidIssueDateBefore14Birthday,
+		},
+		"empty birthday": {
+			modify:    func(id *TemporaryID) { id.Birthday = time.Time{} },
+			checkDate: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			wantField: FieldBirthday,
+			wantErr:   ErrEmptyBirthday,
+		},
+		"empty issue date": {
+			modify:    func(id *TemporaryID) { id.IssueDate = time.Time{} },
+			checkDate: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			wantField: FieldIssueDate,
+			wantErr:   ErrEmptyIssueDate,
+		},
+		"expired": {
+			modify:    func(id *TemporaryID) {},
+			checkDate: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			wantField: FieldExpiryDate,
+			wantErr:   ErrTemporaryIDExpired,
+		},
+		"extended": {
+			modify: func(id *TemporaryID) {
+				id.Extensions = []time.Time{time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)}
+			},
+			checkDate: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"invalid extension": {
+			modify: func(id *TemporaryID) {
+				id.Extensions = []time.Time{time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)}
+			},
+			checkDate: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			wantField: FieldExtensions,
+			wantErr:   ErrInvalidTemporaryIDExtension,
+		},
+	}
+
+	runDocumentCases(t, validTemporaryID, time.Time{}, testCases)
+}
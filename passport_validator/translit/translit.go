@@ -0,0 +1,225 @@
+// Package translit транслитерация русских имен латиницей по таблицам, которые применяются
+// в документах: машиночитаемая зона внутреннего паспорта, загранпаспорта и библиографический
+// ГОСТ 7.79-2000.
+//
+// Обратная транслитерация возможна только для взаимно однозначных таблиц (см. Standard.Reversible).
+// Ее результат состоит из кириллицы, пробелов и дефисов и проверяется валидаторами имен
+// passport_validator, например IsPassportLastNameValid.
+package translit
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Standard таблица транслитерации
+type Standard int
+
+const (
+	// MRZ таблица МВД для машиночитаемой зоны паспорта гражданина РФ: каждой букве соответствует
+	// одна латинская буква или цифра, регистр не сохраняется
+	MRZ Standard = iota + 1
+	// ICAO9303 таблица ICAO Doc 9303, по которой имена пишутся в загранпаспортах с 2014 года
+	ICAO9303
+	// GOST779B ГОСТ 7.79-2000 система Б
+	GOST779B
+	// ForeignPassport1997 таблица, по которой имена писались в загранпаспортах до 2010 года
+	ForeignPassport1997
+)
+
+var (
+	ErrUnknownStandard = errors.New("unknown transliteration standard")
+	ErrNotReversible   = errors.New("transliteration standard is not reversible")
+	ErrUntranslatable  = errors.New("letter is not in the transliteration table")
+)
+
+type table struct {
+	name string
+	// forward латиница для строчных букв
+	forward map[rune]string
+	// reverse кириллица для латиницы, nil если таблица не обратима
+	reverse map[string]rune
+	// tokens ключи reverse, длинные сочетания раньше коротких: "shh" раньше "sh"
+	tokens []string
+	// upperOnly результат всегда заглавными буквами
+	upperOnly bool
+	// digitLetters цифры в латинице обозначают буквы
+	digitLetters bool
+}
+
+var tables = map[Standard]*table{
+	MRZ: newTable("MRZ", true, map[rune]string{
+		'а': "A", 'б': "B", 'в': "V", 'г': "G", 'д': "D", 'е': "E", 'ё': "2", 'ж': "J", 'з': "Z",
+		'и': "I", 'й': "Q", 'к': "K", 'л': "L", 'м': "M", 'н': "N", 'о': "O", 'п': "P", 'р': "R",
+		'с': "S", 'т': "T", 'у': "U", 'ф': "F", 'х': "H", 'ц': "C", 'ч': "3", 'ш': "4", 'щ': "W",
+		'ъ': "X", 'ы': "Y", 'ь': "9", 'э': "6", 'ю': "7", 'я': "8",
+	}),
+	ICAO9303: newTable("ICAO 9303", false, map[rune]string{
+		'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh", 'з': "z",
+		'и': "i", 'й': "i", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r",
+		'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch",
+		'ъ': "ie", 'ы': "y", 'ь': "", 'э': "e", 'ю': "iu", 'я': "ia",
+	}),
+	GOST779B: withReverse(newTable("GOST 7.79-2000 B", true, map[rune]string{
+		'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo", 'ж': "zh", 'з': "z",
+		'и': "i", 'й': "j", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r",
+		'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "x", 'ц': "cz", 'ч': "ch", 'ш': "sh", 'щ': "shh",
+		'ъ': "``", 'ы': "y`", 'ь': "`", 'э': "e`", 'ю': "yu", 'я': "ya",
+	}), "c", 'ц'),
+	ForeignPassport1997: newTable("foreign passport 1997", false, map[rune]string{
+		'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh", 'з': "z",
+		'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r",
+		'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch",
+		'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya",
+	}),
+}
+
+func newTable(name string, reversible bool, forward map[rune]string) *table {
+	t := &table{name: name, forward: forward}
+	for _, latin := range forward {
+		if latin != strings.ToLower(latin) {
+			t.upperOnly = true
+		}
+		if latin != "" && unicode.IsDigit([]rune(latin)[0]) {
+			t.digitLetters = true
+		}
+	}
+	if reversible {
+		t.reverse = make(map[string]rune, len(forward))
+		for cyrillic, latin := range forward {
+			t.reverse[strings.ToLower(latin)] = cyrillic
+		}
+		t.sortTokens()
+	}
+	return t
+}
+
+// withReverse добавляет сочетание, которое встречается в латинице только в зависимости от контекста
+func withReverse(t *table, latin string, cyrillic rune) *table {
+	t.reverse[latin] = cyrillic
+	t.sortTokens()
+	return t
+}
+
+func (t *table) sortTokens() {
+	t.tokens = t.tokens[:0]
+	for latin := range t.reverse {
+		t.tokens = append(t.tokens, latin)
+	}
+	sort.Slice(t.tokens, func(i, j int) bool {
+		if len(t.tokens[i]) != len(t.tokens[j]) {
+			return len(t.tokens[i]) > len(t.tokens[j])
+		}
+		return t.tokens[i] < t.tokens[j]
+	})
+}
+
+func (s Standard) String() string {
+	if t, ok := tables[s]; ok {
+		return t.name
+	}
+	return "unknown"
+}
+
+// Reversible true, если по результату транслитерации однозначно восстанавливается кириллица
+func (s Standard) Reversible() bool {
+	t, ok := tables[s]
+	return ok && t.reverse != nil
+}
+
+// ToLatin транслитерирует кириллицу по таблице std. Символы, не являющиеся буквами, остаются
+// без изменений. Регистр сохраняется: "Щукин" -> "Shchukin", "ЩУКИН" -> "SHCHUKIN".
+func ToLatin(s string, std Standard) (string, error) {
+	t, ok := tables[std]
+	if !ok {
+		return "", ErrUnknownStandard
+	}
+
+	runes := []rune(s)
+	var b strings.Builder
+	for i, r := range runes {
+		lower := unicode.ToLower(r)
+		latin, ok := t.forward[lower]
+		if !ok {
+			if unicode.IsLetter(r) {
+				return "", ErrUntranslatable
+			}
+			b.WriteRune(r)
+			continue
+		}
+
+		// По ГОСТ 7.79 перед e, i, y, j буква ц пишется одной c
+		if std == GOST779B && lower == 'ц' && i+1 < len(runes) {
+			if next := t.forward[unicode.ToLower(runes[i+1])]; next != "" && strings.ContainsAny(next[:1], "eiyj") {
+				latin = "c"
+			}
+		}
+
+		switch {
+		case t.upperOnly || unicode.IsUpper(r) && upperWord(runes, i):
+			latin = strings.ToUpper(latin)
+		case unicode.IsUpper(r) && latin != "":
+			latin = strings.ToUpper(latin[:1]) + latin[1:]
+		}
+		b.WriteString(latin)
+	}
+	return b.String(), nil
+}
+
+// upperWord true, если заглавная буква runes[i] — часть слова, написанного заглавными
+func upperWord(runes []rune, i int) bool {
+	if i+1 < len(runes) && unicode.IsLetter(runes[i+1]) {
+		return unicode.IsUpper(runes[i+1])
+	}
+	return i > 0 && unicode.IsUpper(runes[i-1])
+}
+
+// ToCyrillic восстанавливает кириллицу по обратимой таблице std. Символы, не являющиеся буквами,
+// остаются без изменений. Регистр сохраняется, кроме таблицы MRZ, в которой регистра нет
+// и результат пишется заглавными буквами.
+func ToCyrillic(s string, std Standard) (string, error) {
+	t, ok := tables[std]
+	if !ok {
+		return "", ErrUnknownStandard
+	}
+	if t.reverse == nil {
+		return "", ErrNotReversible
+	}
+
+	var b strings.Builder
+	// upper регистр предыдущей буквы, им пишутся ъ, ь и другие буквы, которые обозначаются не буквой
+	upper := false
+	for len(s) > 0 {
+		lower := strings.ToLower(s)
+		r := []rune(s)[0]
+		matched := false
+		for _, token := range t.tokens {
+			if token == "" || !strings.HasPrefix(lower, token) {
+				continue
+			}
+			if unicode.IsLetter(r) {
+				upper = unicode.IsUpper(r)
+			}
+			cyrillic := t.reverse[token]
+			if t.upperOnly || upper {
+				cyrillic = unicode.ToUpper(cyrillic)
+			}
+			b.WriteRune(cyrillic)
+			s = s[len(token):]
+			matched = true
+			break
+		}
+		if matched {
+			continue
+		}
+
+		if unicode.IsLetter(r) || t.digitLetters && unicode.IsDigit(r) || r == '`' {
+			return "", ErrUntranslatable
+		}
+		b.WriteRune(r)
+		s = s[len(string(r)):]
+	}
+	return b.String(), nil
+}
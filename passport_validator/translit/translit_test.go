@@ -0,0 +1,110 @@
+package translit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ToLatin(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		s       string
+		std     Standard
+		want    string
+		wantErr error
+	}{
+		"mrz":                    {s: "Щепкина-Ёлкина", std: MRZ, want: "WEPKINA-2LKINA"},
+		"mrz special letters":    {s: "Чушь Эмилия Юрьевна", std: MRZ, want: "3U49 6MILI8 7R9EVNA"},
+		"icao":                   {s: "Щукин Артём Юрьевич", std: ICAO9303, want: "Shchukin Artem Iurevich"},
+		"icao upper case":        {s: "ЩУКИН", std: ICAO9303, want: "SHCHUKIN"},
+		"icao capitalized short": {s: "Ян Ли", std: ICAO9303, want: "Ian Li"},
+		"icao hard sign":         {s: "Подъячев", std: ICAO9303, want: "Podieiachev"},
+		"gost":                   {s: "Щукин Артём Юрьевич", std: GOST779B, want: "Shhukin Artyom Yur`evich"},
+		"gost cz":                {s: "Царёв Цицерон Кацюба", std: GOST779B, want: "Czaryov Ciceron Kacyuba"},
+		"gost hard sign":         {s: "Подъячев Эмилия", std: GOST779B, want: "Pod``yachev E`miliya"},
+		"foreign passport 1997":  {s: "Майя Юрьевна Цой", std: ForeignPassport1997, want: "Mayya Yurevna Tsoy"},
+		"latin input":            {s: "Ivan", std: ICAO9303, wantErr: ErrUntranslatable},
+		"unknown standard":       {s: "Иван", std: Standard(0), wantErr: ErrUnknownStandard},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ToLatin(tt.s, tt.std)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_ToCyrillic(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		s       string
+		std     Standard
+		want    string
+		wantErr error
+	}{
+		"mrz":                   {s: "WEPKINA-2LKINA", std: MRZ, want: "ЩЕПКИНА-ЁЛКИНА"},
+		"mrz unused digit":      {s: "IVAN0V", std: MRZ, wantErr: ErrUntranslatable},
+		"gost":                  {s: "Shhukin Artyom Yur`evich", std: GOST779B, want: "Щукин Артём Юрьевич"},
+		"gost upper case":       {s: "POD``YACHEV", std: GOST779B, want: "ПОДЪЯЧЕВ"},
+		"gost c":                {s: "Ciceron Czaryov", std: GOST779B, want: "Цицерон Царёв"},
+		"gost w is not a table": {s: "Walter", std: GOST779B, wantErr: ErrUntranslatable},
+		"icao":                  {s: "Shchukin", std: ICAO9303, wantErr: ErrNotReversible},
+		"foreign passport 1997": {s: "Tsoy", std: ForeignPassport1997, wantErr: ErrNotReversible},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ToCyrillic(tt.s, tt.std)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	names := []string{
+		"Иванов-Петров", "Щукин", "Подъячев", "Цыганова", "Эльвира", "Ёжиков", "Мальцев", "Объедкова", "Шестаков-Щербаков",
+	}
+	for _, std := range []Standard{MRZ, GOST779B} {
+		require.True(t, std.Reversible())
+		for _, name := range names {
+			latin, err := ToLatin(name, std)
+			require.NoError(t, err)
+
+			cyrillic, err := ToCyrillic(latin, std)
+			require.NoError(t, err)
+			if std == MRZ {
+				assert.Equal(t, []rune(name)[0], []rune(cyrillic)[0], "%s %s", std, name)
+				continue
+			}
+			assert.Equal(t, name, cyrillic, "%s %s", std, latin)
+		}
+	}
+	assert.False(t, ICAO9303.Reversible())
+}
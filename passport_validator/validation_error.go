@@ -0,0 +1,292 @@
+package passport_validator
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Пути полей, которые проставляются в ValidationError.Field
+const (
+	FieldLastName   = "last_name"
+	FieldFirstName  = "first_name"
+	FieldMiddleName = "middle_name"
+	FieldSeries     = "series"
+	FieldNumber     = "number"
+	FieldIssueDate  = "issue_date"
+	FieldBirthday   = "birthday"
+	FieldIssuerCode = "issuer_code"
+)
+
+// Severity серьезность найденной проблемы
+type Severity int
+
+const (
+	// SeverityError документ не может быть принят
+	SeverityError Severity = iota
+	// SeverityWarning документ может быть принят, но требует внимания
+	SeverityWarning
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	default:
+		return "unknown"
+	}
+}
+
+// errorCodes стабильные машинные коды ошибок, их можно отдавать клиентам API.
+// Коды нельзя менять после публикации, только добавлять новые.
+var errorCodes = map[error]string{
+	ErrEmptyLastName:                    "last_name_empty",
+	ErrEmptyFirstName:                   "first_name_empty",
+	ErrEmptyPassportSeries:              "series_empty",
+	ErrInvalidPassportSeries:            "series_year_out_of_range",
+	ErrInvalidPassportSeriesNot4Digits:  "series_format",
+	ErrEmptyPassportNumber:              "number_empty",
+	ErrInvalidPassportNumber:            "number_format",
+	ErrInvalidIssueDateBefore14Birthday: "issue_date_before_14",
+	ErrEmptyIssueDate:                   "issue_date_empty",
+	ErrEmptyBirthday:                    "birthday_empty",
+	ErrEmptyIssuedCode:                  "issuer_code_empty",
+	ErrInvalidIssuedCode:                "issuer_code_format",
+	ErrInvalidBirthday:                  "birthday_invalid",
+	ErrNonCyrillicCharacter:             "non_cyrillic_character",
+	ErrIssueDatePassportInFuture:        "issue_date_in_future",
+	ErrIssueDatePassportExpiredAt20:     "expired_at_20",
+	ErrIssueDatePassportExpiredAt45:     "expired_at_45",
+	ErrIssueDatePassportExpired:         "expired_at_age",
+	ErrSeriesYearAfterIssueDate:         "series_year_after_issue_date",
+	ErrSeriesYearTooOld:                 "series_year_too_old",
+	ErrInvalidPassportSeriesRegion:      "series_region_unknown",
+	ErrUnknownIssuedCode:                "issuer_code_unknown",
+	ErrIssuerDirectoryNotLoaded:         "issuer_directory_not_loaded",
+	ErrIssuedCodeNotActive:              "issuer_code_not_active",
+	ErrPassportRegionMismatch:           "region_mismatch",
+	ErrInvalidIssuedCodeLevel:           "issuer_code_level",
+	ErrPassportListedInvalid:            "listed_invalid",
+	ErrInvalidMRZ:                       "mrz_format",
+	ErrInvalidMRZCheckDigit:             "mrz_check_digit",
+	ErrIssueDateBeforeBirthday:          "issue_date_before_birthday",
+
+	ErrInvalidForeignPassportSeries:  "foreign_series_format",
+	ErrInvalidForeignPassportNumber:  "foreign_number_format",
+	ErrInvalidForeignPassportType:    "foreign_type_unknown",
+	ErrNonLatinCharacter:             "non_latin_character",
+	ErrEmptyExpiryDate:               "expiry_date_empty",
+	ErrForeignPassportExpiryMismatch: "foreign_expiry_mismatch",
+	ErrForeignPassportExpired:        "foreign_expired",
+
+	ErrEmptyBirthCertificateSeries:   "birth_certificate_series_empty",
+	ErrInvalidBirthCertificateSeries: "birth_certificate_series_format",
+	ErrInvalidRomanNumeral:           "roman_numeral_invalid",
+	ErrEmptyBirthCertificateNumber:   "birth_certificate_number_empty",
+	ErrInvalidBirthCertificateNumber: "birth_certificate_number_format",
+
+	ErrSovietPassportSeries:              "soviet_passport_series",
+	ErrInvalidSovietPassportSeries:       "soviet_series_format",
+	ErrInvalidSovietPassportNumber:       "soviet_number_format",
+	ErrSovietPassportIssuedBefore16:      "soviet_issue_date_before_16",
+	ErrSovietPassportNoLongerValid:       "soviet_passport_not_valid",
+	ErrSovietPassportIssuedAfterDeadline: "soviet_issue_date_after_deadline",
+
+	ErrEmptyTemporaryIDNumber:       "temporary_id_number_empty",
+	ErrInvalidTemporaryIDNumber:     "temporary_id_number_format",
+	ErrTemporaryIDValidityTooLong:   "temporary_id_validity_too_long",
+	ErrTemporaryIDExpiryBeforeIssue: "temporary_id_expiry_before_issue",
+	ErrInvalidTemporaryIDExtension:  "temporary_id_extension_invalid",
+	ErrTemporaryIDExpired:           "temporary_id_expired",
+
+	ErrEmptyResidencePermitSeries:             "residence_permit_series_empty",
+	ErrInvalidResidencePermitSeries:           "residence_permit_series_format",
+	ErrEmptyResidencePermitNumber:             "residence_permit_number_empty",
+	ErrInvalidResidencePermitNumber:           "residence_permit_number_format",
+	ErrResidencePermitExpiryMismatch:          "residence_permit_expiry_mismatch",
+	ErrResidencePermitExpired:                 "residence_permit_expired",
+	ErrEmptyTemporaryResidencePermitNumber:    "temp_residence_number_empty",
+	ErrInvalidTemporaryResidencePermitNumber:  "temp_residence_number_format",
+	ErrTemporaryResidencePermitExpiryMismatch: "temp_residence_expiry_mismatch",
+	ErrTemporaryResidencePermitExpired:        "temp_residence_expired",
+	ErrUnsupportedDocument:                    "document_type_unsupported",
+	ErrUnknownDocumentType:                    "document_type_unknown",
+	ErrInvalidDocumentPayload:                 "document_payload_invalid",
+	ErrUnknownDocumentField:                   "document_field_unknown",
+	ErrInvalidDateFormat:                      "date_format",
+
+	ErrEmptyMilitaryIDSeries:    "military_id_series_empty",
+	ErrInvalidMilitaryIDSeries:  "military_id_series_format",
+	ErrEmptyMilitaryIDNumber:    "military_id_number_empty",
+	ErrInvalidMilitaryIDNumber:  "military_id_number_format",
+	ErrMilitaryIDIssuedBefore18: "military_id_issue_date_before_18",
+
+	ErrEmptyDriverLicenseSeries:    "driver_license_series_empty",
+	ErrInvalidDriverLicenseSeries:  "driver_license_series_format",
+	ErrEmptyDriverLicenseNumber:    "driver_license_number_empty",
+	ErrInvalidDriverLicenseNumber:  "driver_license_number_format",
+	ErrDriverLicenseIssuedBefore16: "driver_license_before_16",
+	ErrDriverLicenseExpiryMismatch: "driver_license_expiry_mismatch",
+	ErrDriverLicenseExpired:        "driver_license_expired",
+}
+
+// ErrorCode возвращает стабильный код ошибки или пустую строку, если ошибка не из пакета
+func ErrorCode(err error) string {
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		return validationErr.Code
+	}
+
+	for ; err != nil; err = errors.Unwrap(err) {
+		if code, ok := errorCodes[err]; ok {
+			return code
+		}
+	}
+	return ""
+}
+
+// ValidationError ошибка проверки конкретного поля. Оборачивает одну из Err* ошибок пакета,
+// поэтому errors.Is(err, ErrInvalidPassportSeries) продолжает работать.
+type ValidationError struct {
+	// Field путь поля, например "series" или "issue_date"
+	Field string
+	// Code стабильный машинный код ошибки
+	Code     string
+	Severity Severity
+	// Value значение поля, которое не прошло проверку. Содержит персональные данные,
+	// в логи и ответы API нужно отдавать RedactedValue.
+	Value string
+	// Params параметры для подстановки в локализованное сообщение, см. Localize
+	Params map[string]string
+	Err    error
+}
+
+func newValidationError(field, value string, err error) *ValidationError {
+	return &ValidationError{
+		Field:    field,
+		Code:     ErrorCode(err),
+		Severity: SeverityError,
+		Value:    value,
+		Err:      err,
+	}
+}
+
+// Error не содержит значение поля, чтобы персональные данные не попадали в логи
+func (e *ValidationError) Error() string {
+	return e.Field + ": " + e.Err.Error()
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// RedactedValue значение поля, в котором оставлен только первый символ "4617"->"4***"
+func (e *ValidationError) RedactedValue() string {
+	var b strings.Builder
+	for i, c := range []rune(e.Value) {
+		if i == 0 {
+			b.WriteRune(c)
+			continue
+		}
+		b.WriteRune('*')
+	}
+	return b.String()
+}
+
+// ValidationErrors все ошибки, найденные при проверке документа. errors.Is и errors.As
+// проверяют каждую ошибку из списка.
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "; ")
+}
+
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, 0, len(e))
+	for _, err := range e {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// Field возвращает ошибки конкретного поля
+func (e ValidationErrors) Field(field string) ValidationErrors {
+	var errs ValidationErrors
+	for _, err := range e {
+		if err.Field == field {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// Errors возвращает только ошибки с SeverityError
+func (e ValidationErrors) Errors() ValidationErrors {
+	return e.severity(SeverityError)
+}
+
+// Warnings возвращает только предупреждения с SeverityWarning
+func (e ValidationErrors) Warnings() ValidationErrors {
+	return e.severity(SeverityWarning)
+}
+
+func (e ValidationErrors) severity(severity Severity) ValidationErrors {
+	var errs ValidationErrors
+	for _, err := range e {
+		if err.Severity == severity {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// err возвращает nil для пустого списка, чтобы не получить ненулевой интерфейс error
+func (e ValidationErrors) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// fieldErrors собирает ошибки полей в том порядке, в котором поля проверяются
+type fieldErrors ValidationErrors
+
+// check добавляет ошибку поля field со значением value, nil пропускается
+func (e *fieldErrors) check(field, value string, err error) {
+	if err != nil {
+		*e = append(*e, newValidationError(field, value, err))
+	}
+}
+
+// warn как check, но добавляет предупреждение, которое не делает документ недействительным
+func (e *fieldErrors) warn(field, value string, err error) {
+	if err != nil {
+		validationErr := newValidationError(field, value, err)
+		validationErr.Severity = SeverityWarning
+		*e = append(*e, validationErr)
+	}
+}
+
+// checkIssueDate добавляет ошибку проверки даты выдачи issueDate к полю из issueDateErrorField
+func (e *fieldErrors) checkIssueDate(issueDate time.Time, err error) {
+	if field := issueDateErrorField(err); field == FieldBirthday {
+		e.check(field, "", err)
+	} else {
+		e.check(field, formatDate(issueDate), err)
+	}
+}
+
+// issueDateErrorField поле, к которому относится ошибка проверки даты выдачи. Без даты рождения
+// дату выдачи не с чем сверить, поэтому ErrEmptyBirthday показывается на поле даты рождения.
+func issueDateErrorField(err error) string {
+	if errors.Is(err, ErrEmptyBirthday) {
+		return FieldBirthday
+	}
+	return FieldIssueDate
+}
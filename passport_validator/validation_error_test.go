@@ -0,0 +1,94 @@
+package passport_validator
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidationError(t *testing.T) {
+	t.Parallel()
+
+	err := error(newValidationError(FieldSeries, "4696", ErrInvalidPassportSeries))
+
+	assert.ErrorIs(t, err, ErrInvalidPassportSeries)
+	assert.Equal(t, "series: last 2 digits in passport series is before 1997 or after now year", err.Error())
+
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, FieldSeries, validationErr.Field)
+	assert.Equal(t, "series_year_out_of_range", validationErr.Code)
+	assert.Equal(t, SeverityError, validationErr.Severity)
+	assert.Equal(t, "4***", validationErr.RedactedValue())
+}
+
+func Test_ErrorCode(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err  error
+		want string
+	}{
+		"sentinel": {
+			err:  ErrIssueDatePassportExpiredAt45,
+			want: "expired_at_45",
+		},
+		"wrapped sentinel": {
+			err:  fmt.Errorf("check: %w", ErrEmptyPassportNumber),
+			want: "number_empty",
+		},
+		"validation error": {
+			err:  newValidationError(FieldLastName, "musk", ErrNonCyrillicCharacter),
+			want: "non_cyrillic_character",
+		},
+		"foreign error": {
+			err:  errors.New("boom"),
+			want: "",
+		},
+		"nil": {
+			err:  nil,
+			want: "",
+		},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, ErrorCode(tt.err))
+		})
+	}
+}
+
+func Test_ValidationErrors(t *testing.T) {
+	t.Parallel()
+
+	p := validPassport()
+	p.LastName = "musk"
+	p.FirstName = "Elon"
+	p.Number = ""
+
+	err := p.Validate(time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC))
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrNonCyrillicCharacter)
+	assert.ErrorIs(t, err, ErrEmptyPassportNumber)
+	assert.NotErrorIs(t, err, ErrInvalidPassportSeries)
+
+	var errs ValidationErrors
+	require.ErrorAs(t, err, &errs)
+	require.Len(t, errs, 3)
+	assert.Equal(t, "last_name: contains non-Cyrillic symbol; first_name: contains non-Cyrillic symbol; number: passport number is empty", errs.Error())
+
+	lastNameErrs := errs.Field(FieldLastName)
+	require.Len(t, lastNameErrs, 1)
+	assert.Equal(t, "m***", lastNameErrs[0].RedactedValue())
+
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, FieldLastName, validationErr.Field)
+}
@@ -0,0 +1,227 @@
+package passport_validator
+
+import "time"
+
+const (
+	// DefaultMinAge минимальный возраст владельца паспорта по умолчанию
+	DefaultMinAge = 18
+	// DefaultSeriesQuotaYears на сколько лет вперед могут печататься бланки в счет будущих квот
+	DefaultSeriesQuotaYears = 5
+	// DefaultSeriesCarryOverYears на сколько лет год бланка в серии может опережать год выдачи
+	DefaultSeriesCarryOverYears = 1
+	// DefaultSeriesMaxBlankAge сколько лет бланк может пролежать до выдачи
+	DefaultSeriesMaxBlankAge = 5
+	// DefaultTemporaryIDValidityMonths на сколько месяцев выдается и продлевается временное удостоверение личности
+	DefaultTemporaryIDValidityMonths = 1
+	// DefaultForeignPassportChildAge и DefaultForeignPassportChildValidityYears заграничный паспорт,
+	// выданный ребенку младше 14 лет, действителен 5 лет независимо от вида (ст. 10 114-ФЗ)
+	DefaultForeignPassportChildAge           = 14
+	DefaultForeignPassportChildValidityYears = 5
+)
+
+// Clock источник текущего времени, подменяется в тестах
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc позволяет использовать функцию как Clock
+type ClockFunc func() time.Time
+
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// defaultAllowedNameCharacters символы, которые помимо кириллицы допустимы в ФИО
+var defaultAllowedNameCharacters = []rune{'-', ' ', '.', ',', 'I', 'V', '\'', '(', ')'}
+
+// Validator настраиваемый валидатор паспорта. После создания не изменяется,
+// поэтому безопасен для конкурентного использования.
+type Validator struct {
+	clock                 Clock
+	minAge                int
+	seriesQuotaYears      int
+	seriesCarryOverYears  int
+	seriesMaxBlankAge     int
+	graceDays             *int
+	leapDayPolicy         LeapDayPolicy
+	allowedNameCharacters map[rune]bool
+	ruleSets              []RuleSet
+	issuerDirectory       *IssuerDirectory
+	regionExceptions      []RegionException
+	// foreignChildAge и foreignChildValidityYears особый срок действия заграничного паспорта ребенка
+	foreignChildAge           int
+	foreignChildValidityYears int
+	temporaryIDValidityMonths int
+	driverLicenseExtensions   []DriverLicenseExtension
+}
+
+// Option настройка Validator
+type Option func(v *Validator)
+
+// WithClock задает источник текущего времени, относительно которого выполняются проверки
+func WithClock(clock Clock) Option {
+	return func(v *Validator) {
+		v.clock = clock
+	}
+}
+
+// WithMinAge задает минимальный возраст владельца паспорта в годах
+func WithMinAge(years int) Option {
+	return func(v *Validator) {
+		v.minAge = years
+	}
+}
+
+// WithSeriesQuotaYears задает, на сколько лет вперед год бланка в серии может опережать текущий год
+func WithSeriesQuotaYears(years int) Option {
+	return func(v *Validator) {
+		v.seriesQuotaYears = years
+	}
+}
+
+// WithSeriesIssueTolerance задает допустимое расхождение года бланка в серии и года выдачи:
+// carryOverYears — на сколько лет бланк может быть напечатан позже года выдачи в счет будущих квот,
+// maxBlankAge — сколько лет бланк может пролежать до выдачи
+func WithSeriesIssueTolerance(carryOverYears, maxBlankAge int) Option {
+	return func(v *Validator) {
+		v.seriesCarryOverYears = carryOverYears
+		v.seriesMaxBlankAge = maxBlankAge
+	}
+}
+
+// WithIssuerDirectory включает проверку кода подразделения по справочнику: паспорта с кодами, которых
+// в нем нет, отклоняются, а пока справочник пуст — отклоняются с ErrIssuerDirectoryNotLoaded.
+// Без этой опции код подразделения проверяется только по формату.
+func WithIssuerDirectory(directory *IssuerDirectory) Option {
+	return func(v *Validator) {
+		v.issuerDirectory = directory
+	}
+}
+
+// WithRegionExceptions заменяет список допустимых расхождений субъекта в серии и в коде подразделения.
+// Чтобы добавить исключение, сохранив стандартные, передайте append(DefaultRegionExceptions(), exception).
+func WithRegionExceptions(exceptions ...RegionException) Option {
+	return func(v *Validator) {
+		v.regionExceptions = append([]RegionException(nil), exceptions...)
+	}
+}
+
+// WithGraceDays задает, сколько дней после достижения 20 или 45 лет паспорт еще считается действительным.
+// Переопределяет RuleSet.GraceDays во всех наборах правил.
+func WithGraceDays(days int) Option {
+	return func(v *Validator) {
+		v.graceDays = &days
+	}
+}
+
+// WithLeapDayPolicy задает, как считается возраст родившихся 29 февраля, по умолчанию LeapDayFeb28
+func WithLeapDayPolicy(policy LeapDayPolicy) Option {
+	return func(v *Validator) {
+		v.leapDayPolicy = policy
+	}
+}
+
+// WithAllowedNameCharacters заменяет набор символов, которые помимо кириллицы допустимы в ФИО
+func WithAllowedNameCharacters(characters ...rune) Option {
+	return func(v *Validator) {
+		v.allowedNameCharacters = runeSet(characters)
+	}
+}
+
+// WithForeignPassportChildValidity задает срок действия заграничного паспорта, выданного ребенку
+// младше age лет, если он отличается от срока для вида паспорта (см. ForeignPassportType.ValidityYears).
+// По умолчанию DefaultForeignPassportChildValidityYears для детей младше DefaultForeignPassportChildAge,
+// WithForeignPassportChildValidity(0, 0) отключает особый срок.
+func WithForeignPassportChildValidity(age, years int) Option {
+	return func(v *Validator) {
+		v.foreignChildAge = age
+		v.foreignChildValidityYears = years
+	}
+}
+
+// WithTemporaryIDValidity задает, на сколько месяцев выдается временное удостоверение личности
+// и на сколько его можно продлить за один раз
+func WithTemporaryIDValidity(months int) Option {
+	return func(v *Validator) {
+		v.temporaryIDValidityMonths = months
+	}
+}
+
+// WithDriverLicenseExtensions заменяет список автоматических продлений водительских удостоверений.
+// Чтобы добавить продление, сохранив действующие, передайте append(DefaultDriverLicenseExtensions(), extension).
+func WithDriverLicenseExtensions(extensions ...DriverLicenseExtension) Option {
+	return func(v *Validator) {
+		v.driverLicenseExtensions = append([]DriverLicenseExtension(nil), extensions...)
+	}
+}
+
+// NewValidator создает валидатор, без опций поведение совпадает с функциями IsPassport*Valid
+func NewValidator(opts ...Option) *Validator {
+	v := &Validator{
+		clock:                 systemClock{},
+		minAge:                DefaultMinAge,
+		seriesQuotaYears:      DefaultSeriesQuotaYears,
+		seriesCarryOverYears:  DefaultSeriesCarryOverYears,
+		seriesMaxBlankAge:     DefaultSeriesMaxBlankAge,
+		leapDayPolicy:         LeapDayFeb28,
+		allowedNameCharacters: runeSet(defaultAllowedNameCharacters),
+		regionExceptions:      DefaultRegionExceptions(),
+
+		foreignChildAge:           DefaultForeignPassportChildAge,
+		foreignChildValidityYears: DefaultForeignPassportChildValidityYears,
+		temporaryIDValidityMonths: DefaultTemporaryIDValidityMonths,
+		driverLicenseExtensions:   DefaultDriverLicenseExtensions(),
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	v.prepareRuleSets()
+	return v
+}
+
+// defaultValidator используется функциями IsPassport*Valid
+var defaultValidator = NewValidator()
+
+func runeSet(characters []rune) map[rune]bool {
+	set := make(map[rune]bool, len(characters))
+	for _, c := range characters {
+		set[c] = true
+	}
+	return set
+}
+
+func (v *Validator) IsPassportSeriesValid(series string) error {
+	return v.seriesValid(series, v.clock.Now())
+}
+
+func (v *Validator) IsPassportNumberValid(number string) error {
+	return IsPassportNumberValid(number)
+}
+
+func (v *Validator) IsPassportIssueDateValid(issueDate, birthday time.Time) error {
+	return v.issueDateValid(issueDate, birthday, v.clock.Now())
+}
+
+func (v *Validator) IsPassportIssuerCodeValid(issuedCode string) error {
+	return IsPassportIssuerCodeValid(issuedCode)
+}
+
+func (v *Validator) IsPassportBirthdayValid(birthday time.Time) error {
+	return v.birthdayValid(birthday, v.clock.Now())
+}
+
+// ValidatePassport проверяет паспорт целиком на текущую дату, см. Passport.Validate
+func (v *Validator) ValidatePassport(p Passport) error {
+	return v.CheckPassport(p).Err()
+}
+
+// CheckPassport проверяет паспорт целиком на текущую дату, см. Passport.Check
+func (v *Validator) CheckPassport(p Passport) ValidationResult {
+	return v.checkPassport(p, v.clock.Now())
+}
@@ -0,0 +1,132 @@
+package passport_validator
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fixedClock(date time.Time) Clock {
+	return ClockFunc(func() time.Time { return date })
+}
+
+func Test_ValidatorOptions(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		opts     []Option
+		validate func(v *Validator) error
+		wantErr  error
+	}{
+		"default min age 18": {
+			validate: func(v *Validator) error {
+				return v.IsPassportBirthdayValid(time.Date(2008, 2, 20, 0, 0, 0, 0, time.UTC))
+			},
+			wantErr: ErrInvalidBirthday,
+		},
+		"min age 14": {
+			opts: []Option{WithMinAge(14)},
+			validate: func(v *Validator) error {
+				return v.IsPassportBirthdayValid(time.Date(2008, 2, 20, 0, 0, 0, 0, time.UTC))
+			},
+		},
+		"default series quota": {
+			validate: func(v *Validator) error {
+				return v.IsPassportSeriesValid("4629")
+			},
+		},
+		"series quota 1 year": {
+			opts: []Option{WithSeriesQuotaYears(1)},
+			validate: func(v *Validator) error {
+				return v.IsPassportSeriesValid("4629")
+			},
+			wantErr: ErrInvalidPassportSeries,
+		},
+		"default grace days": {
+			validate: func(v *Validator) error {
+				return v.IsPassportIssueDateValid(
+					time.Date(2010, 2, 20, 0, 0, 0, 0, time.UTC),
+					time.Date(1979, 1, 20, 0, 0, 0, 0, time.UTC),
+				)
+			},
+		},
+		"grace days 30": {
+			opts: []Option{WithGraceDays(30)},
+			validate: func(v *Validator) error {
+				return v.IsPassportIssueDateValid(
+					time.Date(2010, 2, 20, 0, 0, 0, 0, time.UTC),
+					time.Date(1979, 1, 20, 0, 0, 0, 0, time.UTC),
+				)
+			},
+			wantErr: ErrIssueDatePassportExpiredAt45,
+		},
+		"default allowed name characters": {
+			validate: func(v *Validator) error {
+				return v.IsPassportLastNameValid("Иванов-Петров")
+			},
+		},
+		"custom allowed name characters": {
+			opts: []Option{WithAllowedNameCharacters(' ')},
+			validate: func(v *Validator) error {
+				return v.IsPassportLastNameValid("Иванов-Петров")
+			},
+			wantErr: ErrNonCyrillicCharacter,
+		},
+		"clock is used for passport": {
+			opts: []Option{WithClock(fixedClock(time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)))},
+			validate: func(v *Validator) error {
+				return v.ValidatePassport(validPassport())
+			},
+			wantErr: ErrIssueDatePassportInFuture,
+		},
+	}
+
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			v := NewValidator(append([]Option{WithClock(fixedClock(now))}, tt.opts...)...)
+
+			err := tt.validate(v)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_ValidatorConcurrentUse(t *testing.T) {
+	t.Parallel()
+
+	v := NewValidator(WithClock(fixedClock(time.Date(2024, 02, 27, 0, 0, 0, 0, time.UTC))))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, v.ValidatePassport(validPassport()))
+		}()
+	}
+	wg.Wait()
+}
+
+func Test_ValidatorSeriesIssueTolerance(t *testing.T) {
+	t.Parallel()
+
+	v := NewValidator(WithSeriesIssueTolerance(0, 15))
+	issueDate := time.Date(2017, 2, 20, 0, 0, 0, 0, time.UTC)
+
+	assert.ErrorIs(t, v.IsPassportSeriesMatchIssueDate("4618", issueDate), ErrSeriesYearAfterIssueDate)
+	assert.NoError(t, v.IsPassportSeriesMatchIssueDate("4605", issueDate))
+}